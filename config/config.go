@@ -8,6 +8,11 @@ import (
 )
 
 var defaultCYBRConfigResolvers = []cybrConfigResolver{
+	// Resolves the default configuration the SDK's API clients will use, and
+	// records the config sources for clients to load service-specific
+	// configuration from.
+	resolveDefaultCYBRConfig,
+
 	resolveSubdomain,
 
 	resolveDomain,
@@ -16,12 +21,20 @@ var defaultCYBRConfigResolvers = []cybrConfigResolver{
 
 	resolveDefaultSubdomain,
 
+	// Sets the ID of the application using the SDK, included in the
+	// User-Agent of the API client requests.
+	resolveAppID,
+
 	// Sets the logger to be used. Could be user provided logger, and client
 	// logging mode.
 	resolveLogger,
 
 	resolveClientLogMode,
 
+	// Sets the credentials the API clients sign requests with, wrapped in a
+	// cybr.CredentialsCache.
+	resolveCredentials,
+
 	// Sets the endpoint resolving behavior the API Clients will use for making
 	// requests to. Clients default to their own clients this allows overrides
 	// to be specified. The resolveEndpointResolver option is deprecated, but
@@ -33,6 +46,10 @@ var defaultCYBRConfigResolvers = []cybrConfigResolver{
 	// the HTTP transport.
 	resolveHTTPClient,
 
+	// Sets the custom CA bundle, if one was provided, on the resolved HTTP
+	// client's transport.
+	resolveCustomCABundle,
+
 	resolveAPIOptions,
 }
 
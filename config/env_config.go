@@ -1,7 +1,9 @@
 package config
 
 import (
+	"bytes"
 	"context"
+	"io"
 	"os"
 
 	"github.com/strick-j/cybr-sdk-alpha/cybr"
@@ -31,6 +33,10 @@ const (
 	cybrSharedCredentialsFileEnvVar = "CYBR_SHARED_CREDENTIALS_FILE"
 
 	cybrConfigFileEnvVar = "CYBR_CONFIG_FILE"
+
+	cybrCustomCABundleEnvVar = "CYBR_CA_BUNDLE"
+
+	cybrAppIDEnvVar = "CYBR_SDK_UA_APP_ID"
 )
 
 var (
@@ -72,6 +78,30 @@ type EnvConfig struct {
 	SharedCredentialsFile string
 
 	SharedConfigFile string
+
+	// Sets the path to a custom Certificate Authority (CA) bundle PEM file
+	// that the SDK will use instead of the system's root CA bundle.
+	// Only use this if you want to configure the SDK to use a custom set
+	// of CAs.
+	//
+	// Enabling this option will attempt to merge the Transport
+	// into the SDK's HTTP client. If the client's Transport is
+	// not a http.Transport an error will be returned. If the
+	// Transport's TLS config is set this option will cause the
+	// SDK to overwrite the Transport's TLS config's RootCAs value.
+	//
+	// Setting a custom HTTPClient in the cybr.Config options will override this
+	// setting. To use this option and custom HTTP client, the HTTP client
+	// needs to be provided when creating the config. Not the service client.
+	//
+	//  CYBR_CA_BUNDLE=$HOME/my_custom_ca_bundle
+	CustomCABundle string
+
+	// The ID of the application using the SDK, included in the User-Agent of
+	// the requests.
+	//
+	//  CYBR_SDK_UA_APP_ID=my-tool
+	AppID string
 }
 
 // loadEnvConfig reads configuration values from the OS's environment variables.
@@ -99,6 +129,10 @@ func NewEnvConfig() (EnvConfig, error) {
 	cfg.SharedCredentialsFile = os.Getenv(cybrSharedCredentialsFileEnvVar)
 	cfg.SharedConfigFile = os.Getenv(cybrConfigFileEnvVar)
 
+	cfg.CustomCABundle = os.Getenv(cybrCustomCABundleEnvVar)
+
+	cfg.AppID = os.Getenv(cybrAppIDEnvVar)
+
 	return cfg, nil
 }
 
@@ -161,6 +195,28 @@ func (c EnvConfig) getSharedCredentialsFiles(context.Context) ([]string, bool, e
 	return files, true, nil
 }
 
+// getCustomCABundle returns the custom CA bundle's PEM bytes if the file was
+// set in the environment.
+func (c EnvConfig) getCustomCABundle(context.Context) (io.Reader, bool, error) {
+	if len(c.CustomCABundle) == 0 {
+		return nil, false, nil
+	}
+
+	b, err := os.ReadFile(c.CustomCABundle)
+	if err != nil {
+		return nil, false, err
+	}
+	return bytes.NewReader(b), true, nil
+}
+
+// getAppID returns the application ID if set in the environment.
+func (c EnvConfig) getAppID(context.Context) (string, bool, error) {
+	if len(c.AppID) == 0 {
+		return "", false, nil
+	}
+	return c.AppID, true, nil
+}
+
 func setStringFromEnvVal(dst *string, keys []string) {
 	for _, k := range keys {
 		if v := os.Getenv(k); len(v) > 0 {
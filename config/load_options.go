@@ -2,6 +2,7 @@ package config
 
 import (
 	"context"
+	"io"
 
 	"github.com/strick-j/cybr-sdk-alpha/cybr"
 	"github.com/strick-j/smithy-go/logging"
@@ -23,9 +24,17 @@ type LoadOptions struct {
 	// Credentials object to use when signing requests.
 	Credentials cybr.CredentialsProvider
 
+	// CredentialsCacheOptions is a function for setting the
+	// cybr.CredentialsCacheOptions of the cache the Credentials are wrapped
+	// in, e.g. to enable proactive refresh of the credentials.
+	CredentialsCacheOptions func(*cybr.CredentialsCacheOptions)
+
 	// HTTPClient the SDK's API clients will use to invoke HTTP requests.
 	HTTPClient HTTPClient
 
+	// CustomCABundle is CA bundle PEM bytes reader
+	CustomCABundle io.Reader
+
 	// Logger writer interface to write logging messages to.
 	Logger logging.Logger
 
@@ -78,6 +87,10 @@ type LoadOptions struct {
 	// LogConfigurationWarnings when set to true, enables logging
 	// configuration warnings
 	LogConfigurationWarnings *bool
+
+	// AppID is the ID of the application using the SDK, included in the
+	// User-Agent of the requests.
+	AppID string
 }
 
 // getDomain returns Domain from config's LoadOptions
@@ -253,6 +266,29 @@ func WithCredentialsProvider(v cybr.CredentialsProvider) LoadOptionsFunc {
 	}
 }
 
+// getCredentialsCacheOptionsProvider returns the wrapped function to set cybr.CredentialsCacheOptions
+func (o LoadOptions) getCredentialsCacheOptions(ctx context.Context) (func(*cybr.CredentialsCacheOptions), bool, error) {
+	if o.CredentialsCacheOptions == nil {
+		return nil, false, nil
+	}
+
+	return o.CredentialsCacheOptions, true, nil
+}
+
+// WithCredentialsCacheOptions is a helper function to construct functional
+// options that sets a function to modify the cybr.CredentialsCacheOptions the
+// cybr.CredentialsCache will be configured with, if the CredentialsCache is used
+// by the configuration loader.
+//
+// If multiple WithCredentialsCacheOptions calls are made, the last call
+// overrides the previous call values.
+func WithCredentialsCacheOptions(v func(*cybr.CredentialsCacheOptions)) LoadOptionsFunc {
+	return func(o *LoadOptions) error {
+		o.CredentialsCacheOptions = v
+		return nil
+	}
+}
+
 func (o LoadOptions) getHTTPClient(ctx context.Context) (HTTPClient, bool, error) {
 	if o.HTTPClient == nil {
 		return nil, false, nil
@@ -273,6 +309,47 @@ func WithHTTPClient(v HTTPClient) LoadOptionsFunc {
 	}
 }
 
+// getCustomCABundle returns CustomCABundle from LoadOptions
+func (o LoadOptions) getCustomCABundle(ctx context.Context) (io.Reader, bool, error) {
+	if o.CustomCABundle == nil {
+		return nil, false, nil
+	}
+
+	return o.CustomCABundle, true, nil
+}
+
+// WithCustomCABundle is a helper function to construct functional options
+// that sets CustomCABundle on config's LoadOptions. Setting the custom CA Bundle
+// to nil will result in custom CA Bundle value being ignored.
+// If multiple WithCustomCABundle calls are made, the last call overrides the
+// previous call values.
+func WithCustomCABundle(v io.Reader) LoadOptionsFunc {
+	return func(o *LoadOptions) error {
+		o.CustomCABundle = v
+		return nil
+	}
+}
+
+// getAppID returns AppID from config's LoadOptions
+func (o LoadOptions) getAppID(ctx context.Context) (string, bool, error) {
+	if len(o.AppID) == 0 {
+		return "", false, nil
+	}
+
+	return o.AppID, true, nil
+}
+
+// WithAppID is a helper function to construct functional options that sets
+// AppID on config's LoadOptions. Setting the AppID to an empty string will
+// result in the AppID value being ignored. If multiple WithAppID calls are
+// made, the last call overrides the previous call values.
+func WithAppID(v string) LoadOptionsFunc {
+	return func(o *LoadOptions) error {
+		o.AppID = v
+		return nil
+	}
+}
+
 func (o LoadOptions) getLogger(ctx context.Context) (logging.Logger, bool, error) {
 	if o.Logger == nil {
 		return nil, false, nil
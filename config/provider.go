@@ -2,6 +2,7 @@ package config
 
 import (
 	"context"
+	"io"
 	"net/http"
 
 	"github.com/strick-j/cybr-sdk-alpha/cybr"
@@ -169,6 +170,28 @@ func getDomain(ctx context.Context, configs configs) (value string, found bool,
 	return
 }
 
+// credentialsCacheOptionsProvider is an interface for retrieving a function for setting
+// the cybr.CredentialsCacheOptions.
+type credentialsCacheOptionsProvider interface {
+	getCredentialsCacheOptions(ctx context.Context) (func(*cybr.CredentialsCacheOptions), bool, error)
+}
+
+// getCredentialsCacheOptionsProvider is an interface for retrieving a function for setting
+// the cybr.CredentialsCacheOptions.
+func getCredentialsCacheOptionsProvider(ctx context.Context, configs configs) (
+	f func(*cybr.CredentialsCacheOptions), found bool, err error,
+) {
+	for _, config := range configs {
+		if p, ok := config.(credentialsCacheOptionsProvider); ok {
+			f, found, err = p.getCredentialsCacheOptions(ctx)
+			if err != nil || found {
+				break
+			}
+		}
+	}
+	return f, found, err
+}
+
 type servicesObjectProvider interface {
 	getServicesObject(ctx context.Context) (map[string]map[string]string, bool, error)
 }
@@ -262,6 +285,46 @@ func getHTTPClient(ctx context.Context, configs configs) (client HTTPClient, fou
 	return
 }
 
+// customCABundleProvider is an interface for retrieving a custom CA bundle
+// PEM file reader from a configuration source.
+type customCABundleProvider interface {
+	getCustomCABundle(ctx context.Context) (io.Reader, bool, error)
+}
+
+// getCustomCABundle searches the configs for a customCABundleProvider and
+// returns the value if found. Returns an error if a provider fails before a
+// value is found.
+func getCustomCABundle(ctx context.Context, configs configs) (value io.Reader, found bool, err error) {
+	for _, cfg := range configs {
+		if p, ok := cfg.(customCABundleProvider); ok {
+			value, found, err = p.getCustomCABundle(ctx)
+			if err != nil || found {
+				break
+			}
+		}
+	}
+	return
+}
+
+// appIDProvider provides access to the ID of the application using the SDK.
+type appIDProvider interface {
+	getAppID(ctx context.Context) (string, bool, error)
+}
+
+// getAppID searches the configs for an appIDProvider and returns the value
+// if found. Returns an error if a provider fails before a value is found.
+func getAppID(ctx context.Context, configs configs) (value string, found bool, err error) {
+	for _, cfg := range configs {
+		if p, ok := cfg.(appIDProvider); ok {
+			value, found, err = p.getAppID(ctx)
+			if err != nil || found {
+				break
+			}
+		}
+	}
+	return
+}
+
 // logConfigurationWarningsProvider is an configuration provider for
 // retrieving a boolean indicating whether configuration issues should
 // be logged when loading from config sources
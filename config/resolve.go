@@ -2,9 +2,15 @@ package config
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net/http"
 	"os"
 
 	"github.com/strick-j/cybr-sdk-alpha/cybr"
+	cybrhttp "github.com/strick-j/cybr-sdk-alpha/cybr/transport/http"
 	"github.com/strick-j/smithy-go/logging"
 )
 
@@ -150,12 +156,17 @@ func resolveClientLogMode(ctx context.Context, cfg *cybr.Config, configs configs
 
 // resolveHTTPClient extracts the first instance of a HTTPClient and sets `cybr.Config.HTTPClient` to the HTTPClient instance
 // if one has not been resolved from other sources.
+//
+// If no HTTPClient was provided the SDK's default HTTPTransportBuilder is
+// used. The builder's transport honors the HTTPS_PROXY, HTTP_PROXY, and
+// NO_PROXY environment variables.
 func resolveHTTPClient(ctx context.Context, cfg *cybr.Config, configs configs) error {
 	c, found, err := getHTTPClient(ctx, configs)
 	if err != nil {
 		return err
 	}
 	if !found {
+		cfg.HTTPClient = cybrhttp.NewHTTPTransportBuilder()
 		return nil
 	}
 
@@ -163,6 +174,76 @@ func resolveHTTPClient(ctx context.Context, cfg *cybr.Config, configs configs) e
 	return nil
 }
 
+// resolveCustomCABundle extracts the first instance of a custom CA bundle
+// filename and adds the certificates it contains to the RootCAs of the
+// resolved HTTP client's transport.
+//
+// The HTTP client must be a *cybrhttp.HTTPTransportBuilder for the custom CA
+// bundle to be applied. A CustomCABundleError is returned if the bundle could
+// not be read, or does not contain any valid PEM encoded certificates.
+//
+// Config providers used:
+// * customCABundleProvider
+func resolveCustomCABundle(ctx context.Context, cfg *cybr.Config, configs configs) error {
+	pemCerts, found, err := getCustomCABundle(ctx, configs)
+	if err != nil {
+		return &CustomCABundleError{Err: err}
+	}
+	if !found {
+		return nil
+	}
+
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = cybrhttp.NewHTTPTransportBuilder()
+	}
+
+	builder, ok := cfg.HTTPClient.(*cybrhttp.HTTPTransportBuilder)
+	if !ok {
+		return &CustomCABundleError{
+			Err: fmt.Errorf("unable to add custom RootCAs to HTTPClient, %T is not a HTTPTransportBuilder", cfg.HTTPClient),
+		}
+	}
+
+	b, err := io.ReadAll(pemCerts)
+	if err != nil {
+		return &CustomCABundleError{Err: fmt.Errorf("failed to read custom CA bundle, %w", err)}
+	}
+
+	var appendErr error
+	cfg.HTTPClient = builder.WithTransportOptions(func(tr *http.Transport) {
+		if tr.TLSClientConfig == nil {
+			tr.TLSClientConfig = &tls.Config{}
+		}
+		if tr.TLSClientConfig.RootCAs == nil {
+			tr.TLSClientConfig.RootCAs = x509.NewCertPool()
+		}
+
+		if !tr.TLSClientConfig.RootCAs.AppendCertsFromPEM(b) {
+			appendErr = fmt.Errorf("no valid PEM encoded certificates found")
+		}
+	})
+	if appendErr != nil {
+		return &CustomCABundleError{Err: appendErr}
+	}
+
+	return nil
+}
+
+// CustomCABundleError is returned when the custom CA bundle provided to the
+// SDK could not be loaded, or did not contain valid PEM encoded certificates.
+type CustomCABundleError struct {
+	Err error
+}
+
+// Unwrap returns the underlying error that caused the failure.
+func (e *CustomCABundleError) Unwrap() error {
+	return e.Err
+}
+
+func (e *CustomCABundleError) Error() string {
+	return fmt.Sprintf("failed to load custom CA bundle, %v", e.Err)
+}
+
 // resolveAPIOptions extracts the first instance of APIOptions and sets `aws.Config.APIOptions` to the resolved API options
 // if one has not been resolved from other sources.
 func resolveAPIOptions(ctx context.Context, cfg *cybr.Config, configs configs) error {
@@ -178,3 +259,57 @@ func resolveAPIOptions(ctx context.Context, cfg *cybr.Config, configs configs) e
 
 	return nil
 }
+
+// resolveCredentials extracts the first instance of a credentials provider
+// from the configs slice, wrapping it in a cybr.CredentialsCache if it is not
+// already cached.
+//
+// Config providers used:
+// * credentialsProviderProvider
+// * credentialsCacheOptionsProvider
+func resolveCredentials(ctx context.Context, cfg *cybr.Config, configs configs) error {
+	provider, found, err := getCredentialsProvider(ctx, configs)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return nil
+	}
+
+	switch provider.(type) {
+	case cybr.AnonymousCredentials, *cybr.AnonymousCredentials, *cybr.CredentialsCache:
+		cfg.Credentials = provider
+		return nil
+	}
+
+	optFn, _, err := getCredentialsCacheOptionsProvider(ctx, configs)
+	if err != nil {
+		return err
+	}
+
+	var optFns []func(*cybr.CredentialsCacheOptions)
+	if optFn != nil {
+		optFns = append(optFns, optFn)
+	}
+	cfg.Credentials = cybr.NewCredentialsCache(provider, optFns...)
+
+	return nil
+}
+
+// resolveAppID extracts the first instance of the ID of the application using
+// the SDK from the configs slice.
+//
+// Config providers used:
+// * appIDProvider
+func resolveAppID(ctx context.Context, cfg *cybr.Config, configs configs) error {
+	v, found, err := getAppID(ctx, configs)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return nil
+	}
+
+	cfg.AppID = v
+	return nil
+}
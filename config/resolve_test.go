@@ -1,10 +1,15 @@
 package config
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/strick-j/cybr-sdk-alpha/cybr"
+	cybrhttp "github.com/strick-j/cybr-sdk-alpha/cybr/transport/http"
 	"github.com/strick-j/cybr-sdk-alpha/internal/cybrtesting/unit"
 
 	"github.com/strick-j/smithy-go/logging"
@@ -60,6 +65,53 @@ func TestGetResolvedDomain(t *testing.T) {
 	}
 }
 
+func TestResolveAppID(t *testing.T) {
+	cases := map[string]struct {
+		Configs configs
+		Expect  string
+	}{
+		"load options": {
+			Configs: configs{
+				LoadOptions{AppID: "from-options"},
+				EnvConfig{AppID: "from-env"},
+				SharedConfig{AppID: "from-shared"},
+			},
+			Expect: "from-options",
+		},
+		"env": {
+			Configs: configs{
+				LoadOptions{},
+				EnvConfig{AppID: "from-env"},
+				SharedConfig{AppID: "from-shared"},
+			},
+			Expect: "from-env",
+		},
+		"shared config": {
+			Configs: configs{
+				LoadOptions{},
+				EnvConfig{},
+				SharedConfig{AppID: "from-shared"},
+			},
+			Expect: "from-shared",
+		},
+		"none": {
+			Configs: configs{LoadOptions{}, EnvConfig{}, SharedConfig{}},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var cfg cybr.Config
+			if err := resolveAppID(context.Background(), &cfg, c.Configs); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if e, a := c.Expect, cfg.AppID; e != a {
+				t.Errorf("expected %v, got %v", e, a)
+			}
+		})
+	}
+}
+
 func TestDefaultDomain(t *testing.T) {
 	ctx := context.Background()
 
@@ -105,6 +157,115 @@ func TestResolveLogger(t *testing.T) {
 	}
 }
 
+func TestResolveCustomCABundle(t *testing.T) {
+	pem, err := os.ReadFile(filepath.Join("testdata", "custom_ca_bundle.pem"))
+	if err != nil {
+		t.Fatalf("failed to read test CA bundle, %v", err)
+	}
+
+	var options LoadOptions
+	WithCustomCABundle(bytes.NewReader(pem))(&options)
+
+	var cfg cybr.Config
+	if err := resolveCustomCABundle(context.Background(), &cfg, configs{options}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	builder, ok := cfg.HTTPClient.(*cybrhttp.HTTPTransportBuilder)
+	if !ok {
+		t.Fatalf("expected HTTPTransportBuilder, got %T", cfg.HTTPClient)
+	}
+	if builder.GetTransport().TLSClientConfig.RootCAs == nil {
+		t.Errorf("expected custom RootCAs to be set on transport")
+	}
+}
+
+func TestResolveCustomCABundle_Invalid(t *testing.T) {
+	cases := map[string]func(*LoadOptions) error{
+		"invalid PEM": func(o *LoadOptions) error {
+			pem, err := os.ReadFile(filepath.Join("testdata", "invalid_ca_bundle.pem"))
+			if err != nil {
+				return err
+			}
+			o.CustomCABundle = bytes.NewReader(pem)
+			return nil
+		},
+		"missing file from environment": func(o *LoadOptions) error {
+			t.Setenv(cybrCustomCABundleEnvVar, filepath.Join("testdata", "file_not_exist"))
+			return nil
+		},
+	}
+
+	for name, optFn := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := LoadDefaultConfig(context.Background(),
+				WithSharedConfigFiles([]string{}),
+				WithSharedCredentialsFiles([]string{}),
+				optFn,
+			)
+			if err == nil {
+				t.Fatalf("expected error, got none")
+			}
+
+			var caErr *CustomCABundleError
+			if !errors.As(err, &caErr) {
+				t.Errorf("expected CustomCABundleError, got %T, %v", err, err)
+			}
+		})
+	}
+}
+
+func TestResolveHTTPClient_Default(t *testing.T) {
+	var cfg cybr.Config
+	if err := resolveHTTPClient(context.Background(), &cfg, configs{LoadOptions{}}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if _, ok := cfg.HTTPClient.(*cybrhttp.HTTPTransportBuilder); !ok {
+		t.Errorf("expected default HTTPTransportBuilder, got %T", cfg.HTTPClient)
+	}
+}
+
 func TestEndpointResolverWithOptionsFunc_ResolveEndpoint(t *testing.T) {
 
 }
+
+func TestResolveCredentials(t *testing.T) {
+	provider := cybr.CredentialsProviderFunc(func(context.Context) (cybr.Credentials, error) {
+		return cybr.Credentials{SessionToken: "token"}, nil
+	})
+
+	var cacheOptions cybr.CredentialsCacheOptions
+	configs := configs{LoadOptions{
+		Credentials: provider,
+		CredentialsCacheOptions: func(o *cybr.CredentialsCacheOptions) {
+			o.ProactiveRefresh = true
+			cacheOptions = *o
+		},
+	}}
+
+	var cfg cybr.Config
+	if err := resolveCredentials(context.Background(), &cfg, configs); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	cache, ok := cfg.Credentials.(*cybr.CredentialsCache)
+	if !ok {
+		t.Fatalf("expected %T credentials, got %T", cache, cfg.Credentials)
+	}
+	if !cache.IsCredentialsProvider(provider) {
+		t.Errorf("expected cache to wrap the credentials provider")
+	}
+	if !cacheOptions.ProactiveRefresh {
+		t.Errorf("expected credentials cache options to be applied")
+	}
+	defer cache.Close()
+
+	creds, err := cfg.Credentials.Retrieve(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if e, a := "token", creds.SessionToken; e != a {
+		t.Errorf("expected %v, got %v", e, a)
+	}
+}
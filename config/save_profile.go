@@ -0,0 +1,288 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/strick-j/cybr-sdk-alpha/internal/ini"
+)
+
+const (
+	// sharedConfigFileMode is the permissions shared config and credentials
+	// files are created with. The credentials file is always written with
+	// these permissions as it contains secrets.
+	sharedConfigFileMode os.FileMode = 0600
+)
+
+// SaveProfileOptions are the options of SaveProfile and DeleteProfile.
+type SaveProfileOptions struct {
+	// ConfigFile is the shared config file to update. Defaults to the file
+	// set by the CYBR_CONFIG_FILE environment variable, or
+	// DefaultSharedConfigFilename.
+	ConfigFile string
+
+	// CredentialsFile is the shared credentials file to update. Defaults to
+	// the file set by the CYBR_SHARED_CREDENTIALS_FILE environment variable,
+	// or DefaultSharedCredentialsFilename.
+	CredentialsFile string
+}
+
+func (o *SaveProfileOptions) setDefaults() {
+	if len(o.ConfigFile) == 0 {
+		o.ConfigFile = os.Getenv(cybrConfigFileEnvVar)
+	}
+	if len(o.ConfigFile) == 0 {
+		o.ConfigFile = DefaultSharedConfigFilename()
+	}
+	if len(o.CredentialsFile) == 0 {
+		o.CredentialsFile = os.Getenv(cybrSharedCredentialsFileEnvVar)
+	}
+	if len(o.CredentialsFile) == 0 {
+		o.CredentialsFile = DefaultSharedCredentialsFilename()
+	}
+}
+
+// SaveProfile writes the profile to the shared config and credentials files,
+// creating the files if they do not exist. The profile's subdomain, domain,
+// ca_bundle, source_profile and services keys are written to the config
+// file, and its credentials to the credentials file. Keys of the profile that
+// are empty are removed from the files.
+//
+// The services section referenced by the profile is replaced with the
+// profile's Services if any are set.
+//
+// Other profiles, keys, and comments in the files are preserved. The files
+// are updated atomically, and created with owner only read and write
+// permissions.
+func SaveProfile(ctx context.Context, cfg SharedConfig, optFns ...func(*SaveProfileOptions)) error {
+	if len(cfg.Profile) == 0 {
+		return fmt.Errorf("profile name is required to save a profile")
+	}
+
+	var o SaveProfileOptions
+	for _, fn := range optFns {
+		fn(&o)
+	}
+	o.setDefaults()
+
+	// values are checked before either file is updated, so a profile that
+	// cannot be saved leaves both files as they were.
+	if err := checkProfileValues(cfg); err != nil {
+		return err
+	}
+
+	// config file
+	sections, mode, err := openSharedConfigFile(o.ConfigFile)
+	if err != nil {
+		return err
+	}
+
+	name := configSectionName(sections, cfg.Profile)
+	section, ok := sections.GetSection(name)
+	if !ok {
+		section = ini.NewSection(name)
+	}
+	setSectionString(section, subdomainKey, cfg.Subdomain)
+	setSectionString(section, domainKey, cfg.Domain)
+	setSectionString(section, caBundleKey, cfg.CustomCABundle)
+	setSectionString(section, appIDKey, cfg.AppID)
+	setSectionString(section, sourceProfileKey, cfg.SourceProfileName)
+	setSectionString(section, servicesSectionKey, cfg.ServicesSectionName)
+	// credentials are only written to the credentials file
+	section.DeleteValue(usernameKey)
+	section.DeleteValue(passwordKey)
+	section.DeleteValue(sessionTokenKey)
+	sections.SetSection(name, section)
+
+	if len(cfg.ServicesSectionName) != 0 && len(cfg.Services.ServiceValues) != 0 {
+		name := servicesPrefix + " " + cfg.ServicesSectionName
+		services, ok := sections.GetSection(name)
+		if !ok {
+			services = ini.NewSection(name)
+		}
+		for _, k := range services.List() {
+			if _, ok := cfg.Services.ServiceValues[k]; !ok {
+				services.DeleteValue(k)
+			}
+		}
+		for _, k := range sortedServiceKeys(cfg.Services.ServiceValues) {
+			v, err := ini.NewMapValue(cfg.Services.ServiceValues[k])
+			if err != nil {
+				return fmt.Errorf("error saving services section %s, %w", cfg.ServicesSectionName, err)
+			}
+			services.UpdateValue(k, v)
+		}
+		sections.SetSection(name, services)
+	}
+
+	if err := ini.WriteFile(o.ConfigFile, sections, mode); err != nil {
+		return SharedConfigSaveError{Filename: o.ConfigFile, Err: err}
+	}
+
+	// credentials file
+	sections, _, err = openSharedConfigFile(o.CredentialsFile)
+	if err != nil {
+		return err
+	}
+
+	section, ok = sections.GetSection(cfg.Profile)
+	if !ok {
+		section = ini.NewSection(cfg.Profile)
+	}
+	creds := cfg.Credentials
+	if !creds.HasKeys() {
+		creds.Username, creds.Password, creds.SessionToken = "", "", ""
+	}
+	setSectionString(section, usernameKey, creds.Username)
+	setSectionString(section, passwordKey, creds.Password)
+	setSectionString(section, sessionTokenKey, creds.SessionToken)
+
+	if len(section.List()) == 0 {
+		sections.DeleteSection(cfg.Profile)
+	} else {
+		sections.SetSection(cfg.Profile, section)
+	}
+
+	if err := ini.WriteFile(o.CredentialsFile, sections, sharedConfigFileMode); err != nil {
+		return SharedConfigSaveError{Filename: o.CredentialsFile, Err: err}
+	}
+
+	return nil
+}
+
+// DeleteProfile removes the profile from the shared config and credentials
+// files. Other profiles, and services sections, in the files are preserved.
+// Returns a SharedConfigProfileNotExistError if the profile is not present in
+// either file.
+func DeleteProfile(ctx context.Context, profile string, optFns ...func(*SaveProfileOptions)) error {
+	var o SaveProfileOptions
+	for _, fn := range optFns {
+		fn(&o)
+	}
+	o.setDefaults()
+
+	var found bool
+
+	sections, mode, err := openSharedConfigFile(o.ConfigFile)
+	if err != nil {
+		return err
+	}
+	if name := configSectionName(sections, profile); sections.HasSection(name) {
+		found = true
+		sections.DeleteSection(name)
+		if err := ini.WriteFile(o.ConfigFile, sections, mode); err != nil {
+			return SharedConfigSaveError{Filename: o.ConfigFile, Err: err}
+		}
+	}
+
+	sections, _, err = openSharedConfigFile(o.CredentialsFile)
+	if err != nil {
+		return err
+	}
+	if sections.HasSection(profile) {
+		found = true
+		sections.DeleteSection(profile)
+		if err := ini.WriteFile(o.CredentialsFile, sections, sharedConfigFileMode); err != nil {
+			return SharedConfigSaveError{Filename: o.CredentialsFile, Err: err}
+		}
+	}
+
+	if !found {
+		return SharedConfigProfileNotExistError{
+			Filename: []string{o.ConfigFile, o.CredentialsFile},
+			Profile:  profile,
+		}
+	}
+	return nil
+}
+
+// openSharedConfigFile parses the file for updating, returning the file's
+// permissions to write the file with. Files that do not exist are treated as
+// empty.
+func openSharedConfigFile(filename string) (ini.Sections, os.FileMode, error) {
+	info, err := os.Stat(filename)
+	if errors.Is(err, os.ErrNotExist) {
+		return ini.NewSections(), sharedConfigFileMode, nil
+	} else if err != nil {
+		return ini.Sections{}, 0, SharedConfigLoadError{Filename: filename, Err: err}
+	}
+
+	sections, err := ini.OpenFile(filename)
+	if err != nil {
+		return ini.Sections{}, 0, SharedConfigLoadError{Filename: filename, Err: err}
+	}
+	return sections, info.Mode().Perm(), nil
+}
+
+// configSectionName returns the name of the profile's section in the shared
+// config file.
+func configSectionName(sections ini.Sections, profile string) string {
+	if profile == DefaultSharedConfigProfile && !sections.HasSection(profilePrefix+profile) {
+		return profile
+	}
+	return profilePrefix + profile
+}
+
+// checkProfileValues returns an error if a value of the profile would not be
+// loaded back as is once saved, e.g. it contains an inline comment.
+func checkProfileValues(cfg SharedConfig) error {
+	values := []struct{ key, value string }{
+		{subdomainKey, cfg.Subdomain},
+		{domainKey, cfg.Domain},
+		{caBundleKey, cfg.CustomCABundle},
+		{appIDKey, cfg.AppID},
+		{sourceProfileKey, cfg.SourceProfileName},
+		{servicesSectionKey, cfg.ServicesSectionName},
+		{usernameKey, cfg.Credentials.Username},
+		{passwordKey, cfg.Credentials.Password},
+		{sessionTokenKey, cfg.Credentials.SessionToken},
+	}
+	for _, v := range values {
+		if _, err := ini.NewStringValue(v.value); err != nil {
+			return fmt.Errorf("error saving profile %s, invalid %s value, %w", cfg.Profile, v.key, err)
+		}
+	}
+	for _, k := range sortedServiceKeys(cfg.Services.ServiceValues) {
+		if _, err := ini.NewMapValue(cfg.Services.ServiceValues[k]); err != nil {
+			return fmt.Errorf("error saving services section %s, %w", cfg.ServicesSectionName, err)
+		}
+	}
+	return nil
+}
+
+func setSectionString(section ini.Section, key, value string) {
+	if len(value) == 0 {
+		section.DeleteValue(key)
+		return
+	}
+	v, _ := ini.NewStringValue(value)
+	section.UpdateValue(key, v)
+}
+
+func sortedServiceKeys(m map[string]map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// SharedConfigSaveError is an error for the shared config file failing to be
+// written.
+type SharedConfigSaveError struct {
+	Filename string
+	Err      error
+}
+
+// Unwrap returns the underlying error that caused the failure.
+func (e SharedConfigSaveError) Unwrap() error {
+	return e.Err
+}
+
+func (e SharedConfigSaveError) Error() string {
+	return fmt.Sprintf("failed to save shared config file, %s, %v", e.Filename, e.Err)
+}
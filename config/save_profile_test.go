@@ -0,0 +1,256 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/strick-j/cybr-sdk-alpha/cybr"
+)
+
+func TestSaveProfile(t *testing.T) {
+	dir := t.TempDir()
+	configFile := filepath.Join(dir, ".cybr", "config")
+	credentialsFile := filepath.Join(dir, ".cybr", "credentials")
+
+	if err := os.MkdirAll(filepath.Dir(configFile), 0700); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	existing := `# managed by hand
+[default]
+subdomain = default_subdomain
+
+[profile dev] # development tenant
+subdomain = old_subdomain
+domain = old.example.com
+custom_key = keep
+`
+	if err := os.WriteFile(configFile, []byte(existing), 0644); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	optFn := func(o *SaveProfileOptions) {
+		o.ConfigFile = configFile
+		o.CredentialsFile = credentialsFile
+	}
+
+	profile := SharedConfig{
+		Profile:             "dev",
+		Subdomain:           "dev_subdomain",
+		ServicesSectionName: "dev_services",
+		Services: Services{
+			ServiceValues: map[string]map[string]string{
+				"generic": {"endpoint_url": "http://localhost:8080"},
+			},
+		},
+		Credentials: cybr.Credentials{
+			Username: "dev_username",
+			Password: "dev_password",
+		},
+	}
+	if err := SaveProfile(context.TODO(), profile, optFn); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	b, err := os.ReadFile(configFile)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	expectConfig := `# managed by hand
+[default]
+subdomain = default_subdomain
+
+[profile dev] # development tenant
+subdomain = dev_subdomain
+custom_key = keep
+services = dev_services
+
+[services dev_services]
+generic =
+  endpoint_url = http://localhost:8080
+`
+	if diff := cmp.Diff(expectConfig, string(b)); len(diff) != 0 {
+		t.Errorf("expect config file to match\n%s", diff)
+	}
+
+	b, err = os.ReadFile(credentialsFile)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	expectCredentials := `[dev]
+cybr_username = dev_username
+cybr_password = dev_password
+`
+	if diff := cmp.Diff(expectCredentials, string(b)); len(diff) != 0 {
+		t.Errorf("expect credentials file to match\n%s", diff)
+	}
+
+	if runtime.GOOS != "windows" {
+		for file, mode := range map[string]os.FileMode{configFile: 0644, credentialsFile: 0600} {
+			info, err := os.Stat(file)
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := mode, info.Mode().Perm(); e != a {
+				t.Errorf("expect %s mode %v, got %v", file, e, a)
+			}
+		}
+	}
+
+	loaded, err := LoadSharedConfigProfile(context.TODO(), "dev", func(o *LoadSharedConfigOptions) {
+		o.ConfigFiles = []string{configFile}
+		o.CredentialsFiles = []string{credentialsFile}
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	profile.Credentials.Source = "SharedConfigCredentials: " + credentialsFile
+	if diff := cmp.Diff(profile, loaded); len(diff) != 0 {
+		t.Errorf("expect saved profile to load\n%s", diff)
+	}
+}
+
+func TestSaveProfile_RoundTrip(t *testing.T) {
+	cases := map[string]struct {
+		Value       string
+		ExpectError string
+	}{
+		"plain":              {Value: "p@ssw0rd"},
+		"hash without space": {Value: "p@ss#1"},
+		"leading hash":       {Value: "#p@ss"},
+		"leading semicolon":  {Value: ";p@ss"},
+		"surrounding spaces": {Value: " p@ss "},
+		"double quoted":      {Value: `"p@ss"`},
+		"single quoted":      {Value: `'p@ss'`},
+		"inner quote":        {Value: `p"ss`},
+		"trailing backslash": {Value: ` p@ss\`},
+		"space hash comment": {
+			Value:       "p@ss #1",
+			ExpectError: "parsed as a comment",
+		},
+		"space semicolon comment": {
+			Value:       "semi ;colon",
+			ExpectError: "parsed as a comment",
+		},
+		"tab hash comment": {
+			Value:       "tab\t#x",
+			ExpectError: "parsed as a comment",
+		},
+		"escaped newline": {
+			Value:       `a\nb`,
+			ExpectError: "parsed as an escape sequence",
+		},
+		"escaped quote": {
+			Value:       `x\"y`,
+			ExpectError: "parsed as an escape sequence",
+		},
+		"line break": {
+			Value:       "a\nb",
+			ExpectError: "line break",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			configFile := filepath.Join(dir, "config")
+			credentialsFile := filepath.Join(dir, "credentials")
+
+			profile := SharedConfig{
+				Profile:   "dev",
+				Subdomain: "dev_subdomain",
+				Credentials: cybr.Credentials{
+					Username: "dev_username",
+					Password: c.Value,
+				},
+			}
+			err := SaveProfile(context.TODO(), profile, func(o *SaveProfileOptions) {
+				o.ConfigFile = configFile
+				o.CredentialsFile = credentialsFile
+			})
+			if len(c.ExpectError) != 0 {
+				if err == nil {
+					t.Fatalf("expect error, got none")
+				}
+				if e, a := c.ExpectError, err.Error(); !strings.Contains(a, e) {
+					t.Errorf("expect %q error, got %q", e, a)
+				}
+				for _, file := range []string{configFile, credentialsFile} {
+					if _, err := os.Stat(file); !errors.Is(err, os.ErrNotExist) {
+						t.Errorf("expect %s not to be written, got %v", file, err)
+					}
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			loaded, err := LoadSharedConfigProfile(context.TODO(), "dev", func(o *LoadSharedConfigOptions) {
+				o.ConfigFiles = []string{configFile}
+				o.CredentialsFiles = []string{credentialsFile}
+			})
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.Value, loaded.Credentials.Password; e != a {
+				t.Errorf("expect %q password, got %q", e, a)
+			}
+		})
+	}
+}
+
+func TestDeleteProfile(t *testing.T) {
+	dir := t.TempDir()
+	configFile := filepath.Join(dir, "config")
+	credentialsFile := filepath.Join(dir, "credentials")
+
+	optFn := func(o *SaveProfileOptions) {
+		o.ConfigFile = configFile
+		o.CredentialsFile = credentialsFile
+	}
+
+	for _, p := range []string{"default", "dev"} {
+		err := SaveProfile(context.TODO(), SharedConfig{
+			Profile:   p,
+			Subdomain: p + "_subdomain",
+			Credentials: cybr.Credentials{
+				Username: p + "_username",
+				Password: p + "_password",
+			},
+		}, optFn)
+		if err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+	}
+
+	if err := DeleteProfile(context.TODO(), "dev", optFn); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	b, err := os.ReadFile(configFile)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "[default]\nsubdomain = default_subdomain\n", string(b); e != a {
+		t.Errorf("expect %q, got %q", e, a)
+	}
+	b, err = os.ReadFile(credentialsFile)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "[default]\ncybr_username = default_username\ncybr_password = default_password\n", string(b); e != a {
+		t.Errorf("expect %q, got %q", e, a)
+	}
+
+	err = DeleteProfile(context.TODO(), "dev", optFn)
+	var notExist SharedConfigProfileNotExistError
+	if !errors.As(err, &notExist) {
+		t.Fatalf("expect %T error, got %v", notExist, err)
+	}
+}
@@ -1,9 +1,11 @@
 package config
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -36,6 +38,19 @@ const (
 	subdomainKey = `subdomain` // required
 	domainKey    = `domain`    // optional
 
+	// Additional config fields
+	caBundleKey = `ca_bundle`
+
+	// The ID of the application using the SDK, included in the User-Agent
+	appIDKey = `sdk_ua_app_id`
+
+	// Services section name, referencing a [services ...] section of
+	// service-specific parameters
+	servicesSectionKey = `services`
+
+	// Service-specific parameter keys of a [services ...] section
+	endpointURLKey = `endpoint_url`
+
 	// DefaultSharedConfigProfile is the default profile to be used when
 	// loading configuration from the config files if another profile name
 	// is not provided.
@@ -106,6 +121,88 @@ type SharedConfig struct {
 	//
 	// domain = cyberark.cloud
 	Domain string
+
+	// CustomCABundle is the file path to a PEM file the SDK will read and
+	// use to configure the HTTP transport with additional CA certs that are
+	// not present in the platforms default CA store.
+	//
+	// Loading the config fails with a CustomCABundleError if the file cannot
+	// be read, e.g. it does not exist, or does not contain any valid PEM
+	// encoded certificates.
+	//
+	// ca_bundle
+	CustomCABundle string
+
+	// The ID of the application using the SDK, included in the User-Agent of
+	// the requests.
+	//
+	// sdk_ua_app_id = my-tool
+	AppID string
+
+	// ServicesSectionName is the name of the [services ...] section the
+	// profile references for service-specific parameters.
+	//
+	// services = mysvc
+	ServicesSectionName string
+
+	// Services are the service-specific parameters of the [services ...]
+	// section referenced by the profile.
+	//
+	//	[services mysvc]
+	//	privilege_cloud =
+	//	  endpoint_url = https://localhost:8443
+	Services Services
+}
+
+// Services contains values for the [services ...] section of the shared
+// config, keyed by the service ID lower cased with its spaces replaced by
+// underscores, e.g. privilege_cloud for the "Privilege Cloud" service. The key
+// may also have its spaces removed, e.g. privilegecloud.
+type Services struct {
+	ServiceValues map[string]map[string]string
+}
+
+func (s *Services) setFromIniSection(section ini.Section) {
+	if s.ServiceValues == nil {
+		s.ServiceValues = make(map[string]map[string]string)
+	}
+	for _, service := range section.List() {
+		s.ServiceValues[service] = section.Map(service)
+	}
+}
+
+// getServicesObject returns the service-specific parameters of the
+// [services ...] section referenced by the profile.
+func (c SharedConfig) getServicesObject(context.Context) (map[string]map[string]string, bool, error) {
+	if c.Services.ServiceValues == nil {
+		return nil, false, nil
+	}
+	return c.Services.ServiceValues, true, nil
+}
+
+// GetServiceBaseEndpoint returns the endpoint_url of the service from the
+// [services ...] section referenced by the profile, if set. The sdkID is the
+// service's ID, e.g. "Privilege Cloud", which is normalized to the key of the
+// service's block, e.g. privilege_cloud or privilegecloud.
+func (c SharedConfig) GetServiceBaseEndpoint(ctx context.Context, sdkID string) (string, bool, error) {
+	for _, key := range normalizeShared(sdkID) {
+		if service, ok := c.Services.ServiceValues[key]; ok {
+			if endpt, ok := service[endpointURLKey]; ok && len(endpt) != 0 {
+				return endpt, true, nil
+			}
+		}
+	}
+	return "", false, nil
+}
+
+// normalizeShared returns the keys the service's block may be named with in
+// the [services ...] section, in order of precedence.
+func normalizeShared(sdkID string) []string {
+	lower := strings.ToLower(sdkID)
+	return []string{
+		strings.ReplaceAll(lower, " ", "_"),
+		strings.ReplaceAll(lower, " ", ""),
+	}
 }
 
 // GetDomain returns the sub domain for the profile if a domain is set.
@@ -124,6 +221,28 @@ func (c SharedConfig) getSubdomain(ctx context.Context) (string, bool, error) {
 	return c.Subdomain, true, nil
 }
 
+// getCustomCABundle returns the custom CA bundle's PEM bytes if the file was
+// set in the profile.
+func (c SharedConfig) getCustomCABundle(context.Context) (io.Reader, bool, error) {
+	if len(c.CustomCABundle) == 0 {
+		return nil, false, nil
+	}
+
+	b, err := os.ReadFile(c.CustomCABundle)
+	if err != nil {
+		return nil, false, err
+	}
+	return bytes.NewReader(b), true, nil
+}
+
+// getAppID returns the application ID of the profile if set.
+func (c SharedConfig) getAppID(context.Context) (string, bool, error) {
+	if len(c.AppID) == 0 {
+		return "", false, nil
+	}
+	return c.AppID, true, nil
+}
+
 // GetCredentialsProvider returns the credentials for a profile if they were set.
 func (c SharedConfig) getCredentialsProvider() (cybr.Credentials, bool, error) {
 	return c.Credentials, true, nil
@@ -303,6 +422,9 @@ func processConfigSections(ctx context.Context, sections *ini.Sections, logger l
 			}
 			skipSections[newName] = struct{}{}
 
+		case strings.HasPrefix(section, servicesPrefix+" "):
+			// keep services sections, they are referenced by profiles
+			// via the services key.
 		case strings.EqualFold(section, "default"):
 		default:
 			// drop this section, as invalid profile name
@@ -451,6 +573,8 @@ func mergeSections(dst *ini.Sections, src ini.Sections) error {
 			sourceProfileKey,
 			domainKey,
 			subdomainKey,
+			caBundleKey,
+			servicesSectionKey,
 		}
 		for i := range stringKeys {
 			if err := mergeStringKey(&srcSection, &dstSection, sectionName, stringKeys[i]); err != nil {
@@ -458,6 +582,15 @@ func mergeSections(dst *ini.Sections, src ini.Sections) error {
 			}
 		}
 
+		// merge the service blocks of services sections
+		if strings.HasPrefix(sectionName, servicesPrefix+" ") {
+			for _, service := range srcSection.List() {
+				v, _ := srcSection.GetValue(service)
+				dstSection.UpdateValue(service, v)
+				dstSection.UpdateSourceFile(service, srcSection.SourceFile[service])
+			}
+		}
+
 		// set srcSection on dst srcSection
 		*dst = dst.SetSection(sectionName, dstSection)
 	}
@@ -516,6 +649,16 @@ func (c *SharedConfig) setFromIniSections(profiles map[string]struct{}, profile
 		return fmt.Errorf("error fetching config from profile, %v, %w", profile, err)
 	}
 
+	// load the services section referenced by the profile, if any
+	if len(c.ServicesSectionName) != 0 {
+		if v, ok := sections.GetSection(servicesPrefix + " " + c.ServicesSectionName); ok {
+			c.Services.setFromIniSection(v)
+		} else if logger != nil {
+			logger.Logf(logging.Debug, "The services section `%v` referenced by profile `%v` "+
+				"does not exist and is ignored.", c.ServicesSectionName, profile)
+		}
+	}
+
 	// if not top level profile and has credentials, return with credentials.
 	if len(profiles) != 0 && c.Credentials.HasKeys() {
 		return nil
@@ -587,6 +730,9 @@ func (c *SharedConfig) setFromIniSection(profile string, section ini.Section) er
 	updateString(&c.Domain, section, domainKey)
 	updateString(&c.Subdomain, section, subdomainKey)
 	updateString(&c.SourceProfileName, section, sourceProfileKey)
+	updateString(&c.CustomCABundle, section, caBundleKey)
+	updateString(&c.AppID, section, appIDKey)
+	updateString(&c.ServicesSectionName, section, servicesSectionKey)
 
 	// Shared Credentials
 	creds := cybr.Credentials{
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"os"
 	"path/filepath"
 	"reflect"
 	"strconv"
@@ -156,6 +157,25 @@ func TestNewSharedConfig(t *testing.T) {
 				Domain:    "default_domain_credentials",
 			},
 		},
+		"services section": {
+			ConfigFilenames: []string{testConfigFilename},
+			Profile:         "services_profile",
+			Expected: SharedConfig{
+				Profile:             "services_profile",
+				Subdomain:           "services_subdomain",
+				ServicesSectionName: "local_services",
+				Services: Services{
+					ServiceValues: map[string]map[string]string{
+						"generic": {
+							"endpoint_url": "http://localhost:8080/generic",
+						},
+						"privilege_cloud": {
+							"endpoint_url": "http://localhost:8443",
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for name, c := range cases {
@@ -881,3 +901,93 @@ func TestSharedConfigLoading(t *testing.T) {
 		})
 	}
 }
+
+func TestSharedConfigGetServiceBaseEndpoint(t *testing.T) {
+	cfg, err := LoadSharedConfigProfile(context.TODO(), "services_profile", func(o *LoadSharedConfigOptions) {
+		o.ConfigFiles = []string{testConfigFilename}
+		o.CredentialsFiles = []string{filepath.Join("testdata", "empty_creds_config")}
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	cases := map[string]struct {
+		SDKID       string
+		Expect      string
+		ExpectFound bool
+	}{
+		"service": {
+			SDKID:       "Generic",
+			Expect:      "http://localhost:8080/generic",
+			ExpectFound: true,
+		},
+		"service ID with spaces": {
+			SDKID:       "Privilege Cloud",
+			Expect:      "http://localhost:8443",
+			ExpectFound: true,
+		},
+		"service not configured": {
+			SDKID: "Identity",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			v, found, err := cfg.GetServiceBaseEndpoint(context.TODO(), c.SDKID)
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.ExpectFound, found; e != a {
+				t.Errorf("expect found %v, got %v", e, a)
+			}
+			if e, a := c.Expect, v; e != a {
+				t.Errorf("expect %v, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestSharedConfigGetServiceBaseEndpoint_DocumentedKey(t *testing.T) {
+	cases := map[string]struct {
+		Key string
+	}{
+		"underscored": {Key: "privilege_cloud"},
+		"no spaces":   {Key: "privilegecloud"},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			configFile := filepath.Join(t.TempDir(), "config")
+			config := `[profile services_profile]
+subdomain = services_subdomain
+services = mysvc
+
+[services mysvc]
+` + c.Key + ` =
+  endpoint_url = https://localhost:8443
+`
+			if err := os.WriteFile(configFile, []byte(config), 0600); err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			cfg, err := LoadSharedConfigProfile(context.TODO(), "services_profile", func(o *LoadSharedConfigOptions) {
+				o.ConfigFiles = []string{configFile}
+				o.CredentialsFiles = []string{filepath.Join("testdata", "empty_creds_config")}
+			})
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			v, found, err := cfg.GetServiceBaseEndpoint(context.TODO(), "Privilege Cloud")
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if !found {
+				t.Fatalf("expect %v endpoint to be found", c.Key)
+			}
+			if e, a := "https://localhost:8443", v; e != a {
+				t.Errorf("expect %v, got %v", e, a)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package cybr
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// clockSkews are the clock skews measured between the local clock and the
+// clocks of the endpoint hosts requests are sent to, keyed by host.
+var clockSkews sync.Map
+
+// RecordClockSkew records the clock skew measured for the endpoint host. The
+// skew is the server's time minus the local time, a positive skew is a
+// server clock ahead of the local clock. The SDK's API clients record the
+// skew measured from the Date header of each response.
+func RecordClockSkew(host string, skew time.Duration) {
+	if len(host) == 0 {
+		return
+	}
+	clockSkews.Store(host, skew)
+}
+
+// GetClockSkew returns the clock skew last measured for the endpoint host,
+// and if one was measured.
+func GetClockSkew(host string) (time.Duration, bool) {
+	v, ok := clockSkews.Load(host)
+	if !ok {
+		return 0, false
+	}
+	return v.(time.Duration), true
+}
+
+// ClockSkews returns the clock skews last measured for each endpoint host,
+// for diagnostics.
+func ClockSkews() map[string]time.Duration {
+	skews := map[string]time.Duration{}
+	clockSkews.Range(func(k, v interface{}) bool {
+		skews[k.(string)] = v.(time.Duration)
+		return true
+	})
+	return skews
+}
+
+// ResetClockSkews clears the clock skews measured.
+func ResetClockSkews() {
+	clockSkews.Range(func(k, _ interface{}) bool {
+		clockSkews.Delete(k)
+		return true
+	})
+}
+
+// expiryClockSkew returns the clock skew credentials expiry is adjusted by.
+// Only a server clock ahead of the local clock is accounted for, expiring the
+// credentials earlier, so a skewed clock never extends the credentials'
+// lifetime. The skew of the host the credentials were issued by is used, the
+// expiry of credentials without a host is not adjusted.
+func expiryClockSkew(host string) time.Duration {
+	if len(host) == 0 {
+		return 0
+	}
+	if skew, _ := GetClockSkew(host); skew > 0 {
+		return skew
+	}
+	return 0
+}
+
+type clockSkewHostKey struct{}
+
+// WithClockSkewHost returns a context carrying the endpoint host a request is
+// sent to. Credentials a CredentialsCache retrieves with the context that do
+// not set a Host are issued the host, so their expiry is adjusted by the
+// clock skew measured for it. The SDK's API clients set the host of the
+// operation's resolved endpoint.
+func WithClockSkewHost(ctx context.Context, host string) context.Context {
+	return context.WithValue(ctx, clockSkewHostKey{}, host)
+}
+
+// getClockSkewHost returns the endpoint host carried by the context, if any.
+func getClockSkewHost(ctx context.Context) string {
+	v, _ := ctx.Value(clockSkewHostKey{}).(string)
+	return v
+}
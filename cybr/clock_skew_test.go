@@ -0,0 +1,80 @@
+package cybr
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/strick-j/cybr-sdk-alpha/internal/sdk"
+)
+
+func TestCredentialsExpired_ClockSkew(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	defer sdk.TestingUseReferenceTime(now)()
+
+	cases := map[string]struct {
+		Skews   map[string]time.Duration
+		Host    string
+		Expires time.Time
+		Expect  bool
+	}{
+		"no skew": {
+			Expires: now.Add(time.Minute),
+		},
+		"host server clock ahead": {
+			Skews:   map[string]time.Duration{"a.example.com": 2 * time.Minute},
+			Host:    "a.example.com",
+			Expires: now.Add(time.Minute),
+			Expect:  true,
+		},
+		"other host skewed": {
+			Skews:   map[string]time.Duration{"b.example.com": 2 * time.Minute},
+			Host:    "a.example.com",
+			Expires: now.Add(time.Minute),
+		},
+		"no host ignores other hosts' skew": {
+			Skews: map[string]time.Duration{
+				"a.example.com": 30 * time.Second,
+				"b.example.com": 2 * time.Minute,
+			},
+			Expires: now.Add(time.Minute),
+		},
+		"server clock behind does not extend expiry": {
+			Skews:   map[string]time.Duration{"a.example.com": -2 * time.Minute},
+			Host:    "a.example.com",
+			Expires: now.Add(-time.Minute),
+			Expect:  true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			ResetClockSkews()
+			defer ResetClockSkews()
+			for host, skew := range c.Skews {
+				RecordClockSkew(host, skew)
+			}
+
+			creds := Credentials{SessionToken: "token", CanExpire: true, Expires: c.Expires, Host: c.Host}
+			if e, a := c.Expect, creds.Expired(); e != a {
+				t.Errorf("expect expired %v, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestClockSkews(t *testing.T) {
+	ResetClockSkews()
+	defer ResetClockSkews()
+
+	RecordClockSkew("a.example.com", time.Second)
+	RecordClockSkew("a.example.com", 2*time.Second)
+	RecordClockSkew("", time.Hour)
+
+	if v, ok := GetClockSkew("a.example.com"); !ok || v != 2*time.Second {
+		t.Errorf("expect last measured skew, got %v, %v", v, ok)
+	}
+	if e, a := map[string]time.Duration{"a.example.com": 2 * time.Second}, ClockSkews(); !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
@@ -1,8 +1,10 @@
 package cybr
 
 import (
+	"context"
 	"net/http"
 
+	"github.com/strick-j/cybr-sdk-alpha/cybr/tracing"
 	"github.com/strick-j/smithy-go/logging"
 	"github.com/strick-j/smithy-go/middleware"
 )
@@ -14,6 +16,20 @@ type HTTPClient interface {
 	Do(*http.Request) (*http.Response, error)
 }
 
+// RateLimiter provides the interface for limiting the rate at which API
+// clients send requests to a CyberArk tenant's services. See the
+// ratelimit.ClientRateLimiter for the SDK's implementation.
+type RateLimiter interface {
+	// GetToken blocks until a request to the service of the tenant subdomain
+	// is allowed to be sent. Returns an error if the context is canceled
+	// before the request is allowed.
+	GetToken(ctx context.Context, subdomain, serviceID string) error
+
+	// ObserveResponse is called with the outcome of each request sent, so the
+	// rate limiter can adapt to the service's throttling responses.
+	ObserveResponse(subdomain, serviceID string, throttled bool)
+}
+
 // Config provides the interface to provide custom configuration.
 type Config struct {
 	// The sub domain to send requests too. Throws an error if not provided.
@@ -43,6 +59,12 @@ type Config struct {
 	// usage information.
 	EndpointResolverWithOptions EndpointResolverWithOptions
 
+	// The base URL the API clients send requests to, overriding the
+	// https://{subdomain}.{domain} endpoint. The path of the URL is preserved
+	// as a prefix of the operation paths, and plain http is allowed, e.g. to
+	// send requests to a local stand-in of the service.
+	BaseEndpoint *string
+
 	// ConfigSources are the sources that were used to construct the Config.
 	// Allows for additional configuration to be loaded by clients.
 	ConfigSources []interface{}
@@ -71,6 +93,28 @@ type Config struct {
 	// Use a (*http.Client) for custom behavior. Using a custom http.Client
 	// will prevent the SDK from modifying the HTTP client.
 	HTTPClient HTTPClient
+
+	// RateLimiter limits the rate at which the API clients send requests.
+	// Client side rate limiting is disabled if nil.
+	//
+	// The RateLimiter is shared by all API clients created from the Config,
+	// limiting the combined rate of requests they send to each tenant
+	// subdomain and service.
+	RateLimiter RateLimiter
+
+	// The Tracer the API clients use to record a span for each operation,
+	// and each request attempt of the operation. Tracing is disabled if both
+	// Tracer and Meter are nil.
+	Tracer tracing.Tracer
+
+	// The Meter the API clients use to record the latency and retry count
+	// metrics of the operations invoked.
+	Meter tracing.Meter
+
+	// The ID of the application using the SDK, included in the User-Agent of
+	// the requests the API clients send, so the requests of an application
+	// can be identified by the tenant's administrators.
+	AppID string
 }
 
 // NewConfig returns a new Config pointer that can be chained with builder
@@ -0,0 +1,393 @@
+package cybr
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	sdkrand "github.com/strick-j/cybr-sdk-alpha/internal/rand"
+	"github.com/strick-j/cybr-sdk-alpha/internal/sdk"
+	"github.com/strick-j/cybr-sdk-alpha/internal/sync/singleflight"
+)
+
+// CredentialsCacheOptions are the options
+type CredentialsCacheOptions struct {
+
+	// ExpiryWindow will allow the credentials to trigger refreshing prior to
+	// the credentials actually expiring. This is beneficial so race conditions
+	// with expiring credentials do not cause request to fail unexpectedly
+	// due to ExpiredTokenException exceptions.
+	//
+	// An ExpiryWindow of 10s would cause calls to IsExpired() to return true
+	// 10 seconds before the credentials are actually expired. This can cause an
+	// increased number of requests to refresh the credentials to occur.
+	//
+	// If ExpiryWindow is 0 or less it will be ignored.
+	ExpiryWindow time.Duration
+
+	// ExpiryWindowJitterFrac provides a mechanism for randomizing the
+	// expiration of credentials within the configured ExpiryWindow by a random
+	// percentage. Valid values are between 0.0 and 1.0.
+	//
+	// As an example if ExpiryWindow is 60 seconds and ExpiryWindowJitterFrac
+	// is 0.5 then credentials will be set to expire between 30 to 60 seconds
+	// prior to their actual expiration time.
+	//
+	// If ExpiryWindow is 0 or less then ExpiryWindowJitterFrac is ignored.
+	// If ExpiryWindowJitterFrac is 0 then no randomization will be applied to the window.
+	// If ExpiryWindowJitterFrac < 0 the value will be treated as 0.
+	// If ExpiryWindowJitterFrac > 1 the value will be treated as 1.
+	ExpiryWindowJitterFrac float64
+
+	// ProactiveRefresh enables refreshing the credentials in the background
+	// ahead of their expiry, so callers do not wait on the refresh. The
+	// credentials are refreshed RefreshAhead before they expire, in addition
+	// to the ExpiryWindow and the clock skew measured for the credentials'
+	// host, but no sooner than half way through their remaining lifetime.
+	//
+	// A failed background refresh is retried with an exponential backoff
+	// until it succeeds. The background refresh is stopped by calling the
+	// cache's Close method.
+	ProactiveRefresh bool
+
+	// RefreshAhead is how long before the credentials expire the background
+	// refresh is performed when ProactiveRefresh is enabled. Defaults to
+	// DefaultRefreshAhead if 0 or less.
+	RefreshAhead time.Duration
+}
+
+// DefaultRefreshAhead is the default duration before the credentials expire
+// the credentials are proactively refreshed.
+const DefaultRefreshAhead = 5 * time.Minute
+
+var (
+	// minRefreshDelay is the least the background refresh waits, and the
+	// delay of the first retry of a failed background refresh.
+	minRefreshDelay = time.Second
+
+	// maxRefreshRetryDelay is the most a retry of a failed background
+	// refresh waits.
+	maxRefreshRetryDelay = time.Minute
+)
+
+// CredentialsCache provides caching and concurrency safe credentials retrieval
+// via the provider's retrieve method.
+//
+// Concurrent calls to Retrieve share a single refresh of the provider's
+// credentials. The refresh is performed on a context detached from the
+// callers' deadlines and cancellation, so a cancelled caller does not fail the
+// refresh other callers are waiting on. A cancelled caller stops waiting and
+// returns its context's error.
+//
+// CredentialsCache will look for optional method on the wrapped provider
+// to adjust the Expires value for cached credentials.
+type CredentialsCache struct {
+	provider CredentialsProvider
+
+	options CredentialsCacheOptions
+	creds   atomic.Value
+	sf      singleflight.Group
+
+	mu      sync.Mutex
+	timer   *time.Timer
+	retries int
+	closed  bool
+}
+
+// NewCredentialsCache returns a CredentialsCache that wraps provider. Provider
+// is expected to not be nil. A variadic list of one or more functions can be
+// provided to modify the CredentialsCache configuration. This allows for
+// configuration of credential expiry window and jitter.
+func NewCredentialsCache(provider CredentialsProvider, optFns ...func(options *CredentialsCacheOptions)) *CredentialsCache {
+	options := CredentialsCacheOptions{}
+
+	for _, fn := range optFns {
+		fn(&options)
+	}
+
+	if options.ExpiryWindow < 0 {
+		options.ExpiryWindow = 0
+	}
+
+	if options.ExpiryWindowJitterFrac < 0 {
+		options.ExpiryWindowJitterFrac = 0
+	} else if options.ExpiryWindowJitterFrac > 1 {
+		options.ExpiryWindowJitterFrac = 1
+	}
+
+	if options.RefreshAhead <= 0 {
+		options.RefreshAhead = DefaultRefreshAhead
+	}
+
+	return &CredentialsCache{
+		provider: provider,
+		options:  options,
+	}
+}
+
+// Retrieve returns the credentials. If the credentials have already been
+// retrieved, and not expired the cached credentials will be returned. If the
+// credentials have not been retrieved yet, or expired the provider's Retrieve
+// method will be called.
+//
+// Credentials retrieved without a Host are issued the endpoint host carried
+// by the context, see WithClockSkewHost.
+//
+// Returns and error if the provider's retrieve method returns an error.
+func (p *CredentialsCache) Retrieve(ctx context.Context) (Credentials, error) {
+	if creds, ok := p.getCreds(); ok && !creds.Expired() {
+		return *creds, nil
+	}
+
+	resCh := p.sf.DoChan("", func() (interface{}, error) {
+		return p.singleRetrieve(&suppressedContext{ctx})
+	})
+	select {
+	case res := <-resCh:
+		return res.Val.(Credentials), res.Err
+	case <-ctx.Done():
+		return Credentials{}, &RequestCanceledError{Err: ctx.Err()}
+	}
+}
+
+func (p *CredentialsCache) singleRetrieve(ctx context.Context) (interface{}, error) {
+	currCreds, ok := p.getCreds()
+	if ok && !currCreds.Expired() {
+		return *currCreds, nil
+	}
+
+	newCreds, err := p.provider.Retrieve(ctx)
+	if err != nil {
+		handleFailToRefresh := defaultHandleFailToRefresh
+		if cs, ok := p.provider.(HandleFailRefreshCredentialsCacheStrategy); ok {
+			handleFailToRefresh = cs.HandleFailToRefresh
+		}
+		var prevCreds Credentials
+		if currCreds != nil {
+			prevCreds = *currCreds
+		}
+		newCreds, err = handleFailToRefresh(ctx, prevCreds, err)
+		if err != nil {
+			return Credentials{}, fmt.Errorf("failed to refresh cached credentials, %w", err)
+		}
+	}
+	if len(newCreds.Host) == 0 {
+		newCreds.Host = getClockSkewHost(ctx)
+	}
+
+	return p.store(newCreds)
+}
+
+// store adjusts the expiry of the credentials by the expiry window, and
+// stores them in the cache.
+func (p *CredentialsCache) store(newCreds Credentials) (Credentials, error) {
+	if newCreds.CanExpire && p.options.ExpiryWindow > 0 {
+		adjustExpiresBy := defaultAdjustExpiresBy
+		if cs, ok := p.provider.(AdjustExpiresByCredentialsCacheStrategy); ok {
+			adjustExpiresBy = cs.AdjustExpiresBy
+		}
+
+		randFloat64, err := sdkrand.CryptoRandFloat64()
+		if err != nil {
+			return Credentials{}, fmt.Errorf("failed to get random provider, %w", err)
+		}
+
+		var jitter time.Duration
+		if p.options.ExpiryWindowJitterFrac > 0 {
+			jitter = time.Duration(randFloat64 *
+				p.options.ExpiryWindowJitterFrac * float64(p.options.ExpiryWindow))
+		}
+
+		newCreds, err = adjustExpiresBy(newCreds, -(p.options.ExpiryWindow - jitter))
+		if err != nil {
+			return Credentials{}, fmt.Errorf("failed to adjust credentials expires, %w", err)
+		}
+	}
+
+	p.creds.Store(&newCreds)
+	p.scheduleRefresh(newCreds)
+
+	return newCreds, nil
+}
+
+// scheduleRefresh schedules the background refresh of the credentials ahead
+// of their expiry, if proactive refresh is enabled.
+func (p *CredentialsCache) scheduleRefresh(creds Credentials) {
+	if !p.options.ProactiveRefresh || !creds.CanExpire {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		return
+	}
+
+	remaining := creds.Expires.Sub(sdk.NowTime())
+	delay := remaining - p.options.RefreshAhead - expiryClockSkew(creds.Host)
+
+	// Credentials living shorter than RefreshAhead would otherwise be
+	// refreshed again as soon as they are retrieved.
+	if half := remaining / 2; delay < half {
+		delay = half
+	}
+	if delay < minRefreshDelay {
+		delay = minRefreshDelay
+	}
+
+	p.retries = 0
+	p.resetTimer(delay)
+}
+
+// scheduleRetry schedules the retry of a failed background refresh. The
+// delay doubles with each consecutive failure, up to maxRefreshRetryDelay.
+func (p *CredentialsCache) scheduleRetry() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		return
+	}
+
+	delay := refreshRetryDelay(p.retries)
+	if delay < maxRefreshRetryDelay {
+		p.retries++
+	}
+	p.resetTimer(delay)
+}
+
+// refreshRetryDelay returns the delay of the retry of a background refresh
+// that failed the number of consecutive times given by retries.
+func refreshRetryDelay(retries int) time.Duration {
+	delay := minRefreshDelay
+	for i := 0; i < retries && delay < maxRefreshRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxRefreshRetryDelay {
+		delay = maxRefreshRetryDelay
+	}
+	return delay
+}
+
+// resetTimer schedules the background refresh after the delay, replacing any
+// refresh already scheduled. The caller must hold p.mu.
+func (p *CredentialsCache) resetTimer(delay time.Duration) {
+	if p.timer != nil {
+		p.timer.Stop()
+	}
+	p.timer = time.AfterFunc(delay, p.refresh)
+}
+
+// refresh retrieves new credentials from the provider in the background,
+// replacing the cached credentials. Callers of Retrieve share the refresh if
+// it is in flight. If the refresh fails the cached credentials are kept until
+// they expire, and the refresh is retried.
+func (p *CredentialsCache) refresh() {
+	p.mu.Lock()
+	closed := p.closed
+	p.mu.Unlock()
+	if closed {
+		return
+	}
+
+	_, err, _ := p.sf.Do("", func() (interface{}, error) {
+		newCreds, err := p.provider.Retrieve(context.Background())
+		if err != nil {
+			return Credentials{}, err
+		}
+		// the background refresh has no request's endpoint host, the host
+		// of the credentials being replaced is kept.
+		if currCreds, ok := p.getCreds(); ok && len(newCreds.Host) == 0 {
+			newCreds.Host = currCreds.Host
+		}
+		return p.store(newCreds)
+	})
+	if err != nil {
+		p.scheduleRetry()
+	}
+}
+
+// Close stops the background refresh of the credentials, if proactive
+// refresh is enabled. The cache continues to refresh the credentials when
+// Retrieve is called after they expire.
+func (p *CredentialsCache) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.closed = true
+	if p.timer != nil {
+		p.timer.Stop()
+		p.timer = nil
+	}
+	return nil
+}
+
+// getCreds returns the currently stored credentials and true. Returning false
+// if no credentials were stored.
+func (p *CredentialsCache) getCreds() (*Credentials, bool) {
+	v := p.creds.Load()
+	if v == nil {
+		return nil, false
+	}
+
+	c := v.(*Credentials)
+	if c == nil || !c.HasKeys() && len(c.SessionToken) == 0 {
+		return nil, false
+	}
+
+	return c, true
+}
+
+// Invalidate will invalidate the cached credentials. The next call to Retrieve
+// will cause the provider's Retrieve method to be called.
+func (p *CredentialsCache) Invalidate() {
+	p.creds.Store((*Credentials)(nil))
+}
+
+// IsCredentialsProvider returns whether credential provider wrapped by CredentialsCache
+// matches the target provider type.
+func (p *CredentialsCache) IsCredentialsProvider(target CredentialsProvider) bool {
+	return IsCredentialsProvider(p.provider, target)
+}
+
+// HandleFailRefreshCredentialsCacheStrategy is an interface for
+// CredentialsCache to allow CredentialsProvider  how failed to refresh
+// credentials is handled.
+type HandleFailRefreshCredentialsCacheStrategy interface {
+	// Given the previously cached Credentials, if any, and refresh error, may
+	// returns new or modified set of Credentials, or error.
+	//
+	// Credential caches may use default implementation if nil.
+	HandleFailToRefresh(context.Context, Credentials, error) (Credentials, error)
+}
+
+// defaultHandleFailToRefresh returns the passed in error.
+func defaultHandleFailToRefresh(ctx context.Context, _ Credentials, err error) (Credentials, error) {
+	return Credentials{}, err
+}
+
+// AdjustExpiresByCredentialsCacheStrategy is an interface for CredentialCache
+// to allow CredentialsProvider to intercept adjustments to Credentials expiry
+// based on expectations and use cases of CredentialsProvider.
+//
+// Credential caches may use default implementation if nil.
+type AdjustExpiresByCredentialsCacheStrategy interface {
+	// Given a Credentials as input, applying any mutations and
+	// returning the potentially updated Credentials, or error.
+	AdjustExpiresBy(Credentials, time.Duration) (Credentials, error)
+}
+
+// defaultAdjustExpiresBy adds the duration to the passed in credentials Expires,
+// and returns the updated credentials value. If Credentials value's CanExpire
+// is false, the passed in credentials are returned unchanged.
+func defaultAdjustExpiresBy(creds Credentials, dur time.Duration) (Credentials, error) {
+	if !creds.CanExpire {
+		return creds, nil
+	}
+
+	creds.Expires = creds.Expires.Add(dur)
+	return creds, nil
+}
@@ -0,0 +1,272 @@
+package cybr
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCredentialsCache_Cache(t *testing.T) {
+	var called int32
+	p := NewCredentialsCache(CredentialsProviderFunc(func(ctx context.Context) (Credentials, error) {
+		atomic.AddInt32(&called, 1)
+		return Credentials{SessionToken: "token", Source: "test"}, nil
+	}))
+
+	for i := 0; i < 3; i++ {
+		creds, err := p.Retrieve(context.Background())
+		if err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+		if e, a := "token", creds.SessionToken; e != a {
+			t.Errorf("expect %v, got %v", e, a)
+		}
+	}
+	if e, a := int32(1), atomic.LoadInt32(&called); e != a {
+		t.Errorf("expect provider called %v times, got %v", e, a)
+	}
+
+	p.Invalidate()
+	if _, err := p.Retrieve(context.Background()); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := int32(2), atomic.LoadInt32(&called); e != a {
+		t.Errorf("expect provider called %v times after invalidate, got %v", e, a)
+	}
+}
+
+func TestCredentialsCache_Expired(t *testing.T) {
+	var called int32
+	p := NewCredentialsCache(CredentialsProviderFunc(func(ctx context.Context) (Credentials, error) {
+		atomic.AddInt32(&called, 1)
+		return Credentials{
+			SessionToken: "token",
+			CanExpire:    true,
+			Expires:      time.Now().Add(5 * time.Second),
+		}, nil
+	}), func(o *CredentialsCacheOptions) {
+		o.ExpiryWindow = 10 * time.Second
+	})
+
+	for i := 0; i < 2; i++ {
+		if _, err := p.Retrieve(context.Background()); err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+	}
+	if e, a := int32(2), atomic.LoadInt32(&called); e != a {
+		t.Errorf("expect credentials within the expiry window refreshed, %v calls, got %v", e, a)
+	}
+}
+
+func TestCredentialsCache_DetachedFromCallerCancellation(t *testing.T) {
+	release := make(chan struct{})
+	var called int32
+	p := NewCredentialsCache(CredentialsProviderFunc(func(ctx context.Context) (Credentials, error) {
+		atomic.AddInt32(&called, 1)
+		<-release
+		if err := ctx.Err(); err != nil {
+			return Credentials{}, err
+		}
+		return Credentials{SessionToken: "token"}, nil
+	}))
+
+	// the first caller is canceled while the refresh is in flight
+	ctx, cancel := context.WithCancel(context.Background())
+	canceled := make(chan error)
+	go func() {
+		_, err := p.Retrieve(ctx)
+		canceled <- err
+	}()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 5)
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := p.Retrieve(context.Background())
+			errs <- err
+		}()
+	}
+
+	cancel()
+	err := <-canceled
+	var cancelErr *RequestCanceledError
+	if !errors.As(err, &cancelErr) {
+		t.Errorf("expect %T error for canceled caller, got %v", cancelErr, err)
+	}
+
+	close(release)
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Errorf("expect no error for waiting callers, got %v", err)
+		}
+	}
+
+	if e, a := int32(1), atomic.LoadInt32(&called); e != a {
+		t.Errorf("expect refresh shared by callers, %v calls, got %v", e, a)
+	}
+}
+
+func setRefreshDelays(t *testing.T, min, max time.Duration) {
+	origMin, origMax := minRefreshDelay, maxRefreshRetryDelay
+	minRefreshDelay, maxRefreshRetryDelay = min, max
+	t.Cleanup(func() {
+		minRefreshDelay, maxRefreshRetryDelay = origMin, origMax
+	})
+}
+
+func TestCredentialsCache_ProactiveRefresh(t *testing.T) {
+	setRefreshDelays(t, time.Millisecond, 10*time.Millisecond)
+
+	refreshed := make(chan struct{}, 10)
+	var called int32
+	p := NewCredentialsCache(CredentialsProviderFunc(func(ctx context.Context) (Credentials, error) {
+		n := atomic.AddInt32(&called, 1)
+		if n > 1 {
+			refreshed <- struct{}{}
+		}
+		return Credentials{
+			SessionToken: "token",
+			CanExpire:    true,
+			Expires:      time.Now().Add(200 * time.Millisecond),
+		}, nil
+	}), func(o *CredentialsCacheOptions) {
+		o.ProactiveRefresh = true
+		o.RefreshAhead = 150 * time.Millisecond
+	})
+
+	if _, err := p.Retrieve(context.Background()); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	select {
+	case <-refreshed:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("expect credentials to be refreshed in the background")
+	}
+
+	if err := p.Close(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	// allow a refresh in flight when closed to complete
+	time.Sleep(20 * time.Millisecond)
+	after := atomic.LoadInt32(&called)
+
+	time.Sleep(50 * time.Millisecond)
+	if e, a := after, atomic.LoadInt32(&called); e != a {
+		t.Errorf("expect no refresh after close, %v calls, got %v", e, a)
+	}
+}
+
+func TestCredentialsCache_ProactiveRefreshShortLived(t *testing.T) {
+	setRefreshDelays(t, time.Millisecond, 10*time.Millisecond)
+
+	var called int32
+	p := NewCredentialsCache(CredentialsProviderFunc(func(ctx context.Context) (Credentials, error) {
+		atomic.AddInt32(&called, 1)
+		return Credentials{
+			SessionToken: "token",
+			CanExpire:    true,
+			Expires:      time.Now().Add(200 * time.Millisecond),
+		}, nil
+	}), func(o *CredentialsCacheOptions) {
+		o.ProactiveRefresh = true
+	})
+	defer p.Close()
+
+	if _, err := p.Retrieve(context.Background()); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	// Credentials living shorter than RefreshAhead are refreshed half way
+	// through their lifetime, not continuously.
+	time.Sleep(50 * time.Millisecond)
+	if e, a := int32(1), atomic.LoadInt32(&called); e != a {
+		t.Errorf("expect no refresh before half the lifetime, %v calls, got %v", e, a)
+	}
+
+	time.Sleep(500 * time.Millisecond)
+	if a := atomic.LoadInt32(&called); a < 2 || a > 10 {
+		t.Errorf("expect a few background refreshes, got %v calls", a)
+	}
+}
+
+func TestCredentialsCache_ProactiveRefreshError(t *testing.T) {
+	setRefreshDelays(t, time.Millisecond, 10*time.Millisecond)
+
+	refreshed := make(chan struct{}, 1)
+	var called int32
+	p := NewCredentialsCache(CredentialsProviderFunc(func(ctx context.Context) (Credentials, error) {
+		switch n := atomic.AddInt32(&called, 1); {
+		case n == 1:
+			return Credentials{
+				SessionToken: "token",
+				CanExpire:    true,
+				Expires:      time.Now().Add(400 * time.Millisecond),
+			}, nil
+		case n < 4:
+			return Credentials{}, errors.New("refresh error")
+		case n == 4:
+			refreshed <- struct{}{}
+		}
+		return Credentials{
+			SessionToken: "refreshed token",
+			CanExpire:    true,
+			Expires:      time.Now().Add(time.Hour),
+		}, nil
+	}), func(o *CredentialsCacheOptions) {
+		o.ProactiveRefresh = true
+	})
+	defer p.Close()
+
+	creds, err := p.Retrieve(context.Background())
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "token", creds.SessionToken; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+
+	select {
+	case <-refreshed:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("expect the failed background refresh to be retried")
+	}
+
+	// Wait for the refreshed credentials to be stored.
+	for i := 0; i < 100; i++ {
+		if creds, err = p.Retrieve(context.Background()); err != nil || creds.SessionToken != "token" {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "refreshed token", creds.SessionToken; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
+
+func TestRefreshRetryDelay(t *testing.T) {
+	setRefreshDelays(t, time.Second, 5*time.Second)
+
+	cases := map[int]time.Duration{
+		0:  time.Second,
+		1:  2 * time.Second,
+		2:  4 * time.Second,
+		3:  5 * time.Second,
+		64: 5 * time.Second,
+	}
+	for retries, expect := range cases {
+		if e, a := expect, refreshRetryDelay(retries); e != a {
+			t.Errorf("expect %v delay after %v retries, got %v", e, retries, a)
+		}
+	}
+}
@@ -41,15 +41,26 @@ type Credentials struct {
 	// The time the credentials will expire at. Should be ignored if CanExpire
 	// is false.
 	Expires time.Time
+
+	// The endpoint host the credentials were issued by. Used to adjust the
+	// credentials' expiry by the clock skew measured for the host. If empty
+	// the expiry is not adjusted. A CredentialsCache used by the SDK's API
+	// clients sets the host of the endpoint the credentials are used with if
+	// empty, see WithClockSkewHost.
+	Host string
 }
 
-// Expired returns if the credentials have expired.
+// Expired returns if the credentials have expired. The expiry is adjusted by
+// the clock skew measured for the host the credentials were issued by, so
+// credentials are considered expired before the server would reject them when
+// the local clock is behind the server's.
 func (v Credentials) Expired() bool {
 	if v.CanExpire {
 		// Calling Round(0) on the current time will truncate the monotonic
 		// reading only. Ensures credential expiry time is always based on
 		// reported wall-clock time.
-		return !v.Expires.After(sdk.NowTime().Round(0))
+		now := sdk.NowTime().Round(0).Add(expiryClockSkew(v.Host))
+		return !v.Expires.After(now)
 	}
 
 	return false
@@ -1,5 +1,7 @@
 package cybr
 
+import "fmt"
+
 // MissingSubdomainError is an error that is returned if Subdomain configuration
 // value was not found.
 type MissingSubdomainError struct{}
@@ -15,3 +17,21 @@ type MissingDomainError struct{}
 func (*MissingDomainError) Error() string {
 	return "a CyberArk Domain is required, but was not found"
 }
+
+// RequestCanceledError is the error that will be returned by an API request
+// that was canceled. Requests given a Context may return this error when
+// canceled.
+type RequestCanceledError struct {
+	Err error
+}
+
+// CanceledError returns true to satisfy interfaces checking for canceled errors.
+func (*RequestCanceledError) CanceledError() bool { return true }
+
+// Unwrap returns the underlying error, if there was one.
+func (e *RequestCanceledError) Unwrap() error {
+	return e.Err
+}
+func (e *RequestCanceledError) Error() string {
+	return fmt.Sprintf("request canceled, %v", e.Err)
+}
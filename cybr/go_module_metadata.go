@@ -0,0 +1,7 @@
+package cybr
+
+// goModuleVersion is the tagged release for this module. No release of the
+// module has been tagged yet, so the version is a development placeholder.
+// It must be set to the tag's version, without the leading v, in the commit
+// the release is tagged at.
+const goModuleVersion = "0.0.0-dev"
@@ -0,0 +1,87 @@
+// Package logging provides adapters for the SDK's logging.Logger interface.
+package logging
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	cybrmiddleware "github.com/strick-j/cybr-sdk-alpha/cybr/middleware"
+	"github.com/strick-j/smithy-go/logging"
+)
+
+// Attribute keys added to the records logged by the SlogLogger.
+const (
+	ClassificationKey = "classification"
+	ServiceIDKey      = "service"
+	OperationNameKey  = "operation"
+)
+
+// SlogLogger is a logging.Logger implementation that delegates logging to a
+// log/slog Logger. The SDK's log classifications are mapped to slog levels,
+// logging.Debug to slog.LevelDebug, logging.Warn to slog.LevelWarn, and any
+// other classification to slog.LevelInfo.
+//
+// SlogLogger implements logging.ContextLogger. When used by a client the
+// records are logged with the context of the operation, and the service ID
+// and operation name of the operation are added as attributes.
+type SlogLogger struct {
+	Logger *slog.Logger
+
+	ctx context.Context
+}
+
+// NewSlogLogger returns a SlogLogger wrapping the provided slog Logger. If the
+// logger is nil slog.Default is used.
+func NewSlogLogger(logger *slog.Logger) *SlogLogger {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &SlogLogger{Logger: logger}
+}
+
+// Logf logs the formatted message to the underlying slog Logger at the level
+// the classification maps to.
+func (s *SlogLogger) Logf(classification logging.Classification, format string, v ...interface{}) {
+	ctx := s.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	level := slogLevel(classification)
+	if !s.Logger.Enabled(ctx, level) {
+		return
+	}
+
+	var attrs []slog.Attr
+	if len(classification) != 0 {
+		attrs = append(attrs, slog.String(ClassificationKey, string(classification)))
+	}
+	if v := cybrmiddleware.GetServiceID(ctx); len(v) != 0 {
+		attrs = append(attrs, slog.String(ServiceIDKey, v))
+	}
+	if v := cybrmiddleware.GetOperationName(ctx); len(v) != 0 {
+		attrs = append(attrs, slog.String(OperationNameKey, v))
+	}
+
+	s.Logger.LogAttrs(ctx, level, fmt.Sprintf(format, v...), attrs...)
+}
+
+// WithContext returns a copy of the SlogLogger that logs with the provided
+// context.
+func (s *SlogLogger) WithContext(ctx context.Context) logging.Logger {
+	c := *s
+	c.ctx = ctx
+	return &c
+}
+
+func slogLevel(classification logging.Classification) slog.Level {
+	switch classification {
+	case logging.Debug:
+		return slog.LevelDebug
+	case logging.Warn:
+		return slog.LevelWarn
+	default:
+		return slog.LevelInfo
+	}
+}
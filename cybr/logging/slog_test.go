@@ -0,0 +1,61 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/strick-j/smithy-go/logging"
+)
+
+func TestSlogLogger(t *testing.T) {
+	cases := map[string]struct {
+		Classification logging.Classification
+		Level          slog.Level
+		Expect         string
+	}{
+		"debug": {
+			Classification: logging.Debug,
+			Level:          slog.LevelDebug,
+			Expect:         "level=DEBUG msg=\"hello world\" classification=DEBUG",
+		},
+		"warn": {
+			Classification: logging.Warn,
+			Level:          slog.LevelDebug,
+			Expect:         "level=WARN msg=\"hello world\" classification=WARN",
+		},
+		"unclassified": {
+			Level:  slog.LevelDebug,
+			Expect: "level=INFO msg=\"hello world\"",
+		},
+		"disabled level": {
+			Classification: logging.Debug,
+			Level:          slog.LevelInfo,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			handler := slog.NewTextHandler(&buf, &slog.HandlerOptions{
+				Level: c.Level,
+				ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+					if a.Key == slog.TimeKey {
+						return slog.Attr{}
+					}
+					return a
+				},
+			})
+
+			var logger logging.Logger = NewSlogLogger(slog.New(handler))
+			logger = logging.WithContext(context.Background(), logger)
+			logger.Logf(c.Classification, "hello %s", "world")
+
+			if e, a := c.Expect, strings.TrimSpace(buf.String()); e != a {
+				t.Errorf("expect %q, got %q", e, a)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/strick-j/cybr-sdk-alpha/cybr"
 	"github.com/strick-j/cybr-sdk-alpha/internal/rand"
 	"github.com/strick-j/cybr-sdk-alpha/internal/sdk"
 	"github.com/strick-j/smithy-go/logging"
@@ -22,7 +23,10 @@ func (r *ClientRequestID) ID() string {
 	return "ClientRequestID"
 }
 
-// HandleBuild attaches a unique operation invocation id for the operation to the request
+// HandleBuild attaches a unique operation invocation id for the operation to the request.
+// The invocation id, and the number and latencies of the request attempts made
+// by the operation, are added to the operation's metadata. See GetInvocationID,
+// GetAttemptCount and GetAttemptLatencies.
 func (r ClientRequestID) HandleBuild(ctx context.Context, in middleware.BuildInput, next middleware.BuildHandler) (
 	out middleware.BuildOutput, metadata middleware.Metadata, err error,
 ) {
@@ -39,7 +43,97 @@ func (r ClientRequestID) HandleBuild(ctx context.Context, in middleware.BuildInp
 	const invocationIDHeader = "Cybr-Sdk-Invocation-Id"
 	req.Header[invocationIDHeader] = append(req.Header[invocationIDHeader][:0], invocationID)
 
-	return next.HandleBuild(ctx, in)
+	attempts := &requestAttempts{}
+	ctx = middleware.WithStackValue(ctx, requestAttemptsKey{}, attempts)
+
+	out, metadata, err = next.HandleBuild(ctx, in)
+
+	setInvocationID(&metadata, invocationID)
+	if len(attempts.latencies) > 0 {
+		setAttemptCount(&metadata, len(attempts.latencies))
+		setAttemptLatencies(&metadata, attempts.latencies)
+	}
+
+	return out, metadata, err
+}
+
+// requestAttemptsKey is the stack value key of the request attempts made by
+// the operation.
+type requestAttemptsKey struct{}
+
+// requestAttempts are the request attempts made by an operation.
+type requestAttempts struct {
+	// The number of the attempt in progress, starting at 1.
+	count int
+
+	// The latencies of the completed attempts.
+	latencies []time.Duration
+}
+
+// maxAttemptsKey is the stack value key of the maximum number of request
+// attempts of the operation.
+type maxAttemptsKey struct{}
+
+// SetMaxAttempts sets the maximum number of request attempts the operation
+// makes on the context. A retry middleware sets the maximum before the
+// request attempts are made, so it is sent in the attempt header of the
+// requests, see RequestAttempt. Defaults to a single attempt if not set.
+//
+// Scoped to stack values. Use github.com/aws/smithy-go/middleware#ClearStackValues
+// to clear all stack values.
+func SetMaxAttempts(ctx context.Context, value int) context.Context {
+	return middleware.WithStackValue(ctx, maxAttemptsKey{}, value)
+}
+
+// getMaxAttempts returns the maximum number of request attempts of the
+// operation, 1 if not set.
+func getMaxAttempts(ctx context.Context) int {
+	if v, ok := middleware.GetStackValue(ctx, maxAttemptsKey{}).(int); ok && v > 0 {
+		return v
+	}
+	return 1
+}
+
+// RequestAttempt is a Smithy FinalizeMiddleware that sets the attempt header
+// of each request attempt made by the operation, with the number of the
+// attempt and the maximum number of attempts, e.g.
+//
+//	Cybr-Sdk-Request: attempt=2; max=3
+//
+// The latency of each attempt is recorded to the operation's metadata by the
+// ClientRequestID middleware. A retry middleware must be inserted before
+// RequestAttempt so each attempt is counted.
+type RequestAttempt struct{}
+
+// ID returns the middleware identifier.
+func (r *RequestAttempt) ID() string {
+	return "RequestAttempt"
+}
+
+// HandleFinalize sets the attempt header of the request, and records the
+// latency of the attempt.
+func (r RequestAttempt) HandleFinalize(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (
+	out middleware.FinalizeOutput, metadata middleware.Metadata, err error,
+) {
+	req, ok := in.Request.(*smithyhttp.Request)
+	if !ok {
+		return out, metadata, fmt.Errorf("unknown transport type %T", in.Request)
+	}
+
+	attempts, ok := middleware.GetStackValue(ctx, requestAttemptsKey{}).(*requestAttempts)
+	if !ok {
+		attempts = &requestAttempts{}
+	}
+	attempts.count++
+
+	const attemptHeader = "Cybr-Sdk-Request"
+	req.Header.Set(attemptHeader, fmt.Sprintf("attempt=%d; max=%d", attempts.count, getMaxAttempts(ctx)))
+
+	start := sdk.NowTime()
+	out, metadata, err = next.HandleFinalize(ctx, in)
+	attempts.latencies = append(attempts.latencies, sdk.NowTime().Sub(start))
+
+	return out, metadata, err
 }
 
 // RecordResponseTiming records the response timing for the SDK client requests.
@@ -50,7 +144,8 @@ func (a *RecordResponseTiming) ID() string {
 	return "RecordResponseTiming"
 }
 
-// HandleDeserialize calculates response metadata and clock skew
+// HandleDeserialize calculates response metadata and clock skew. The clock
+// skew is recorded for the request's endpoint host, see cybr.RecordClockSkew.
 func (a RecordResponseTiming) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
 	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
 ) {
@@ -80,6 +175,10 @@ func (a RecordResponseTiming) HandleDeserialize(ctx context.Context, in middlewa
 	if !serverTime.IsZero() {
 		attemptSkew := serverTime.Sub(responseAt)
 		setAttemptSkew(&metadata, attemptSkew)
+
+		if req, ok := in.Request.(*smithyhttp.Request); ok {
+			cybr.RecordClockSkew(req.URL.Host, attemptSkew)
+		}
 	}
 
 	return out, metadata, err
@@ -111,6 +210,49 @@ func setServerTime(metadata *middleware.Metadata, v time.Time) {
 	metadata.Set(serverTimeKey{}, v)
 }
 
+type invocationIDKey struct{}
+
+// GetInvocationID returns the unique id of the operation invocation, sent in
+// the Cybr-Sdk-Invocation-Id header of the requests.
+func GetInvocationID(metadata middleware.Metadata) (v string, ok bool) {
+	v, ok = metadata.Get(invocationIDKey{}).(string)
+	return v, ok
+}
+
+// setInvocationID sets the invocation id on the metadata.
+func setInvocationID(metadata *middleware.Metadata, v string) {
+	metadata.Set(invocationIDKey{}, v)
+}
+
+type attemptsKey struct{}
+
+// GetAttemptCount returns the number of request attempts made by the
+// operation.
+func GetAttemptCount(metadata middleware.Metadata) (v int, ok bool) {
+	v, ok = metadata.Get(attemptsKey{}).(int)
+	return v, ok
+}
+
+// setAttemptCount sets the number of request attempts on the metadata.
+func setAttemptCount(metadata *middleware.Metadata, v int) {
+	metadata.Set(attemptsKey{}, v)
+}
+
+type attemptLatenciesKey struct{}
+
+// GetAttemptLatencies returns the latency of each request attempt made by
+// the operation, in the order of the attempts.
+func GetAttemptLatencies(metadata middleware.Metadata) (v []time.Duration, ok bool) {
+	v, ok = metadata.Get(attemptLatenciesKey{}).([]time.Duration)
+	return v, ok
+}
+
+// setAttemptLatencies sets the latencies of the request attempts on the
+// metadata.
+func setAttemptLatencies(metadata *middleware.Metadata, v []time.Duration) {
+	metadata.Set(attemptLatenciesKey{}, v)
+}
+
 type attemptSkewKey struct{}
 
 // GetAttemptSkew returns Attempt clock skew for response from metadata.
@@ -129,6 +271,11 @@ func AddClientRequestIDMiddleware(stack *middleware.Stack) error {
 	return stack.Build.Add(&ClientRequestID{}, middleware.After)
 }
 
+// AddRequestAttemptMiddleware adds RequestAttempt to the middleware stack
+func AddRequestAttemptMiddleware(stack *middleware.Stack) error {
+	return stack.Finalize.Add(&RequestAttempt{}, middleware.After)
+}
+
 // AddRecordResponseTiming adds RecordResponseTiming middleware to the
 // middleware stack.
 func AddRecordResponseTiming(stack *middleware.Stack) error {
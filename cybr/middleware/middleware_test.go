@@ -0,0 +1,136 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/strick-j/cybr-sdk-alpha/cybr"
+	"github.com/strick-j/cybr-sdk-alpha/internal/sdk"
+	"github.com/strick-j/smithy-go/middleware"
+	smithyhttp "github.com/strick-j/smithy-go/transport/http"
+)
+
+func TestRecordResponseTiming(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	defer sdk.TestingUseReferenceTime(now)()
+	cybr.ResetClockSkews()
+	defer cybr.ResetClockSkews()
+
+	req := smithyhttp.NewStackRequest().(*smithyhttp.Request)
+	req.URL.Host = "example.cyberark.cloud"
+
+	_, metadata, err := RecordResponseTiming{}.HandleDeserialize(context.Background(),
+		middleware.DeserializeInput{Request: req},
+		middleware.DeserializeHandlerFunc(func(ctx context.Context, in middleware.DeserializeInput) (
+			out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+		) {
+			out.RawResponse = &smithyhttp.Response{Response: &http.Response{
+				StatusCode: 200,
+				Header: http.Header{
+					"Date": []string{now.Add(time.Minute).Format(http.TimeFormat)},
+				},
+			}}
+			return out, metadata, nil
+		}),
+	)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if v, ok := GetAttemptSkew(metadata); !ok || v != time.Minute {
+		t.Errorf("expect attempt skew %v, got %v, %v", time.Minute, v, ok)
+	}
+	if v, ok := cybr.GetClockSkew("example.cyberark.cloud"); !ok || v != time.Minute {
+		t.Errorf("expect host clock skew %v, got %v, %v", time.Minute, v, ok)
+	}
+}
+
+func TestRequestAttempt(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	origNowTime := sdk.NowTime
+	defer func() { sdk.NowTime = origNowTime }()
+	sdk.NowTime = func() time.Time { return now }
+
+	stack := middleware.NewStack("TestOperation", smithyhttp.NewStackRequest)
+	if err := AddClientRequestIDMiddleware(stack); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if err := AddRequestAttemptMiddleware(stack); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	// retries the request once
+	err := stack.Finalize.Insert(middleware.FinalizeMiddlewareFunc("TestRetry", func(
+		ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler,
+	) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		ctx = SetMaxAttempts(ctx, 3)
+		if _, _, err := next.HandleFinalize(ctx, in); err != nil {
+			return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+		}
+		return next.HandleFinalize(ctx, in)
+	}), "RequestAttempt", middleware.Before)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	var attemptHeaders, invocationIDs []string
+	handler := middleware.DecorateHandler(middleware.HandlerFunc(func(ctx context.Context, input interface{}) (
+		output interface{}, metadata middleware.Metadata, err error,
+	) {
+		req := input.(*smithyhttp.Request)
+		attemptHeaders = append(attemptHeaders, req.Header.Get("Cybr-Sdk-Request"))
+		invocationIDs = append(invocationIDs, req.Header.Get("Cybr-Sdk-Invocation-Id"))
+		now = now.Add(time.Duration(len(attemptHeaders)) * time.Second)
+		return &smithyhttp.Response{Response: &http.Response{StatusCode: 200}}, metadata, nil
+	}), stack)
+
+	_, metadata, err := handler.Handle(context.Background(), struct{}{})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := []string{"attempt=1; max=3", "attempt=2; max=3"}, attemptHeaders; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect attempt headers %v, got %v", e, a)
+	}
+
+	invocationID, ok := GetInvocationID(metadata)
+	if !ok || len(invocationID) == 0 {
+		t.Fatalf("expect invocation id in metadata")
+	}
+	for _, id := range invocationIDs {
+		if e, a := invocationID, id; e != a {
+			t.Errorf("expect invocation id header %v, got %v", e, a)
+		}
+	}
+
+	if count, ok := GetAttemptCount(metadata); !ok || count != 2 {
+		t.Errorf("expect 2 attempts, got %v, %v", count, ok)
+	}
+	latencies, ok := GetAttemptLatencies(metadata)
+	if !ok {
+		t.Fatalf("expect attempt latencies in metadata")
+	}
+	if e, a := []time.Duration{time.Second, 2 * time.Second}, latencies; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect attempt latencies %v, got %v", e, a)
+	}
+}
+
+func TestRequestAttempt_DefaultMaxAttempts(t *testing.T) {
+	req := smithyhttp.NewStackRequest().(*smithyhttp.Request)
+
+	_, _, err := RequestAttempt{}.HandleFinalize(context.Background(), middleware.FinalizeInput{Request: req},
+		middleware.FinalizeHandlerFunc(func(ctx context.Context, in middleware.FinalizeInput) (
+			out middleware.FinalizeOutput, metadata middleware.Metadata, err error,
+		) {
+			return out, metadata, nil
+		}),
+	)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "attempt=1; max=1", req.Header.Get("Cybr-Sdk-Request"); e != a {
+		t.Errorf("expect attempt header %v, got %v", e, a)
+	}
+}
@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/strick-j/cybr-sdk-alpha/cybr"
+	"github.com/strick-j/smithy-go/middleware"
+	smithyhttp "github.com/strick-j/smithy-go/transport/http"
+)
+
+// RateLimit is a Smithy FinalizeMiddleware that waits for the rate limiter
+// to allow a request to be sent to the operation's tenant subdomain and
+// service. The HTTP status of the response is reported back to the rate
+// limiter so it can adapt to throttling responses.
+type RateLimit struct {
+	RateLimiter cybr.RateLimiter
+}
+
+// ID returns the middleware identifier.
+func (m *RateLimit) ID() string {
+	return "RateLimit"
+}
+
+// HandleFinalize waits for a token from the rate limiter before sending the
+// request.
+func (m *RateLimit) HandleFinalize(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (
+	out middleware.FinalizeOutput, metadata middleware.Metadata, err error,
+) {
+	subdomain, serviceID := GetSubdomain(ctx), GetServiceID(ctx)
+
+	if err := m.RateLimiter.GetToken(ctx, subdomain, serviceID); err != nil {
+		return out, metadata, fmt.Errorf("failed to get rate limit token, %w", err)
+	}
+
+	out, metadata, err = next.HandleFinalize(ctx, in)
+
+	resp, ok := GetRawResponse(metadata).(*smithyhttp.Response)
+	if !ok || resp == nil {
+		return out, metadata, err
+	}
+	m.RateLimiter.ObserveResponse(subdomain, serviceID, resp.StatusCode == http.StatusTooManyRequests)
+
+	return out, metadata, err
+}
+
+// AddRateLimitMiddleware adds the RateLimit middleware to the end of the
+// finalize step of the middleware stack. The middleware is not added if the
+// rate limiter is nil.
+func AddRateLimitMiddleware(stack *middleware.Stack, rateLimiter cybr.RateLimiter) error {
+	if rateLimiter == nil {
+		return nil
+	}
+	return stack.Finalize.Add(&RateLimit{RateLimiter: rateLimiter}, middleware.After)
+}
@@ -0,0 +1,119 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/strick-j/smithy-go/middleware"
+	smithyhttp "github.com/strick-j/smithy-go/transport/http"
+)
+
+type recordingRateLimiter struct {
+	tokenErr error
+
+	tokens    []string
+	observed  []string
+	throttled []bool
+}
+
+func (l *recordingRateLimiter) GetToken(ctx context.Context, subdomain, serviceID string) error {
+	l.tokens = append(l.tokens, subdomain+"/"+serviceID)
+	return l.tokenErr
+}
+
+func (l *recordingRateLimiter) ObserveResponse(subdomain, serviceID string, throttled bool) {
+	l.observed = append(l.observed, subdomain+"/"+serviceID)
+	l.throttled = append(l.throttled, throttled)
+}
+
+func TestRateLimit(t *testing.T) {
+	cases := map[string]struct {
+		TokenErr        error
+		StatusCode      int
+		ExpectErr       bool
+		ExpectSent      bool
+		ExpectThrottled []bool
+	}{
+		"success": {
+			StatusCode:      200,
+			ExpectSent:      true,
+			ExpectThrottled: []bool{false},
+		},
+		"throttled": {
+			StatusCode:      http.StatusTooManyRequests,
+			ExpectSent:      true,
+			ExpectThrottled: []bool{true},
+		},
+		"token error": {
+			TokenErr:  context.DeadlineExceeded,
+			ExpectErr: true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			limiter := &recordingRateLimiter{tokenErr: c.TokenErr}
+
+			ctx := SetServiceID(context.Background(), "Privilege Cloud")
+			ctx = setSubdomain(ctx, "example")
+
+			var sent bool
+			_, _, err := (&RateLimit{RateLimiter: limiter}).HandleFinalize(ctx, middleware.FinalizeInput{},
+				middleware.FinalizeHandlerFunc(func(ctx context.Context, in middleware.FinalizeInput) (
+					out middleware.FinalizeOutput, metadata middleware.Metadata, err error,
+				) {
+					sent = true
+					metadata.Set(rawResponseKey{}, &smithyhttp.Response{Response: &http.Response{StatusCode: c.StatusCode}})
+					return out, metadata, nil
+				}),
+			)
+			if c.ExpectErr {
+				if err == nil {
+					t.Fatalf("expect error, got none")
+				}
+				if !errors.Is(err, c.TokenErr) {
+					t.Errorf("expect %v error, got %v", c.TokenErr, err)
+				}
+			} else if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			if e, a := c.ExpectSent, sent; e != a {
+				t.Errorf("expect request sent %v, got %v", e, a)
+			}
+			if e, a := []string{"example/Privilege Cloud"}, limiter.tokens; len(a) != 1 || e[0] != a[0] {
+				t.Errorf("expect token for %v, got %v", e, a)
+			}
+			if e, a := len(c.ExpectThrottled), len(limiter.throttled); e != a {
+				t.Fatalf("expect %v observed responses, got %v", e, a)
+			}
+			for i, e := range c.ExpectThrottled {
+				if a := limiter.throttled[i]; e != a {
+					t.Errorf("expect throttled %v, got %v", e, a)
+				}
+				if e, a := "example/Privilege Cloud", limiter.observed[i]; e != a {
+					t.Errorf("expect response observed for %v, got %v", e, a)
+				}
+			}
+		})
+	}
+}
+
+func TestAddRateLimitMiddleware(t *testing.T) {
+	stack := middleware.NewStack("TestOperation", smithyhttp.NewStackRequest)
+	if err := AddRateLimitMiddleware(stack, nil); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if _, ok := stack.Finalize.Get((&RateLimit{}).ID()); ok {
+		t.Errorf("expect no middleware without a rate limiter")
+	}
+
+	if err := AddRateLimitMiddleware(stack, &recordingRateLimiter{}); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if _, ok := stack.Finalize.Get((&RateLimit{}).ID()); !ok {
+		t.Errorf("expect the middleware to be added")
+	}
+}
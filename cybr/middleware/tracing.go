@@ -0,0 +1,161 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/strick-j/cybr-sdk-alpha/cybr/tracing"
+	"github.com/strick-j/cybr-sdk-alpha/internal/sdk"
+	"github.com/strick-j/smithy-go/middleware"
+	smithyhttp "github.com/strick-j/smithy-go/transport/http"
+)
+
+// attemptCountKey is the stack value key of the number of request attempts
+// made by the operation.
+type attemptCountKey struct{}
+
+// OperationTracing is a Smithy InitializeMiddleware that records a span for
+// the operation invoked, and the operation's duration metric.
+//
+// The middleware must be added after RegisterServiceMetadata so the service ID
+// and operation name are available, and before any other initialize
+// middleware, e.g. the input validation, so their failures are recorded.
+type OperationTracing struct {
+	Tracer tracing.Tracer
+
+	duration tracing.Histogram
+}
+
+// ID returns the middleware identifier.
+func (m *OperationTracing) ID() string {
+	return "OperationTracing"
+}
+
+// HandleInitialize starts the operation span, and records the operation
+// metrics once the operation completes.
+func (m *OperationTracing) HandleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (
+	out middleware.InitializeOutput, metadata middleware.Metadata, err error,
+) {
+	serviceID, operation := GetServiceID(ctx), GetOperationName(ctx)
+	attrs := []tracing.Attribute{
+		tracing.String(tracing.ServiceIDKey, serviceID),
+		tracing.String(tracing.OperationNameKey, operation),
+	}
+
+	ctx, span := m.Tracer.StartSpan(ctx, fmt.Sprintf("%s.%s", serviceID, operation), attrs...)
+	defer span.End()
+
+	ctx = middleware.WithStackValue(ctx, attemptCountKey{}, new(int))
+
+	start := sdk.NowTime()
+	out, metadata, err = next.HandleInitialize(ctx, in)
+
+	span.SetAttributes(responseAttributes(metadata)...)
+	if err != nil {
+		span.SetError(err)
+	}
+
+	m.duration.Record(ctx, sdk.NowTime().Sub(start).Seconds(), attrs...)
+
+	return out, metadata, err
+}
+
+// AttemptTracing is a Smithy FinalizeMiddleware that records a span, and the
+// duration metric, for each request attempt made by the operation.
+type AttemptTracing struct {
+	Tracer tracing.Tracer
+
+	duration tracing.Histogram
+}
+
+// ID returns the middleware identifier.
+func (m *AttemptTracing) ID() string {
+	return "AttemptTracing"
+}
+
+// HandleFinalize starts the attempt span, and records the attempt duration
+// once the attempt completes.
+func (m *AttemptTracing) HandleFinalize(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (
+	out middleware.FinalizeOutput, metadata middleware.Metadata, err error,
+) {
+	attempt := 1
+	if attempts, ok := middleware.GetStackValue(ctx, attemptCountKey{}).(*int); ok {
+		*attempts++
+		attempt = *attempts
+	}
+
+	serviceID, operation := GetServiceID(ctx), GetOperationName(ctx)
+	attrs := []tracing.Attribute{
+		tracing.String(tracing.ServiceIDKey, serviceID),
+		tracing.String(tracing.OperationNameKey, operation),
+	}
+
+	ctx, span := m.Tracer.StartSpan(ctx, fmt.Sprintf("%s.%s attempt", serviceID, operation),
+		append(attrs, tracing.Int(tracing.AttemptKey, attempt))...)
+	defer span.End()
+
+	start := sdk.NowTime()
+	out, metadata, err = next.HandleFinalize(ctx, in)
+
+	span.SetAttributes(responseAttributes(metadata)...)
+	if err != nil {
+		span.SetError(err)
+	}
+
+	m.duration.Record(ctx, sdk.NowTime().Sub(start).Seconds(), attrs...)
+
+	return out, metadata, err
+}
+
+// responseAttributes returns the status code and request ID attributes of the
+// response stored in the metadata, if any.
+func responseAttributes(metadata middleware.Metadata) []tracing.Attribute {
+	var attrs []tracing.Attribute
+	if resp, ok := GetRawResponse(metadata).(*smithyhttp.Response); ok && resp != nil {
+		attrs = append(attrs, tracing.Int(tracing.StatusCodeKey, resp.StatusCode))
+	}
+	if reqID, ok := GetRequestIDMetadata(metadata); ok && len(reqID) != 0 {
+		attrs = append(attrs, tracing.String(tracing.RequestIDKey, reqID))
+	}
+	return attrs
+}
+
+// AddTracingMiddleware adds the OperationTracing and AttemptTracing
+// middleware to the stack. Nil tracer or meter values default to their no-op
+// implementations. The middleware are not added if both are nil.
+//
+// The OperationTracing middleware is inserted first in the initialize step,
+// directly after RegisterServiceMetadata if the stack has it, so the failures
+// of the initialize middleware, e.g. the input validation, are recorded.
+func AddTracingMiddleware(stack *middleware.Stack, tracer tracing.Tracer, meter tracing.Meter) error {
+	if tracer == nil && meter == nil {
+		return nil
+	}
+	if tracer == nil {
+		tracer = tracing.NopTracer{}
+	}
+	if meter == nil {
+		meter = tracing.NopMeter{}
+	}
+
+	operationTracing := &OperationTracing{
+		Tracer: tracer,
+		duration: meter.Histogram(tracing.OperationDurationMetric, "s",
+			"The duration of the operation, including all request attempts."),
+	}
+	var err error
+	if _, ok := stack.Initialize.Get((&RegisterServiceMetadata{}).ID()); ok {
+		err = stack.Initialize.Insert(operationTracing, (&RegisterServiceMetadata{}).ID(), middleware.After)
+	} else {
+		err = stack.Initialize.Add(operationTracing, middleware.Before)
+	}
+	if err != nil {
+		return err
+	}
+
+	return stack.Finalize.Add(&AttemptTracing{
+		Tracer: tracer,
+		duration: meter.Histogram(tracing.AttemptDurationMetric, "s",
+			"The duration of a single request attempt."),
+	}, middleware.After)
+}
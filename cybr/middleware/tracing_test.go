@@ -0,0 +1,163 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/strick-j/cybr-sdk-alpha/cybr/tracing"
+	"github.com/strick-j/smithy-go/middleware"
+	smithyhttp "github.com/strick-j/smithy-go/transport/http"
+)
+
+type recordedSpan struct {
+	name  string
+	attrs map[string]interface{}
+	err   error
+	ended bool
+}
+
+func (s *recordedSpan) SetAttributes(attrs ...tracing.Attribute) {
+	for _, a := range attrs {
+		s.attrs[a.Key] = a.Value
+	}
+}
+func (s *recordedSpan) SetError(err error) { s.err = err }
+func (s *recordedSpan) End()               { s.ended = true }
+
+type recordingTracer struct {
+	spans []*recordedSpan
+}
+
+func (t *recordingTracer) StartSpan(ctx context.Context, name string, attrs ...tracing.Attribute) (context.Context, tracing.Span) {
+	s := &recordedSpan{name: name, attrs: map[string]interface{}{}}
+	s.SetAttributes(attrs...)
+	t.spans = append(t.spans, s)
+	return ctx, s
+}
+
+type recordingMeter struct {
+	values map[string][]float64
+}
+
+func (m *recordingMeter) Histogram(name, _, _ string) tracing.Histogram {
+	return histogramFunc(func(v float64) { m.values[name] = append(m.values[name], v) })
+}
+
+type histogramFunc func(float64)
+
+func (fn histogramFunc) Record(_ context.Context, v float64, _ ...tracing.Attribute) { fn(v) }
+
+func TestAddTracingMiddleware(t *testing.T) {
+	tracer := &recordingTracer{}
+	meter := &recordingMeter{values: map[string][]float64{}}
+
+	stack := middleware.NewStack("TestOperation", smithyhttp.NewStackRequest)
+	if err := stack.Initialize.Add(&RegisterServiceMetadata{
+		ServiceID:     "TestService",
+		OperationName: "TestOperation",
+	}, middleware.Before); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if err := AddRawResponseToMetadata(stack); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if err := AddTracingMiddleware(stack, tracer, meter); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	handler := middleware.DecorateHandler(middleware.HandlerFunc(
+		func(ctx context.Context, in interface{}) (interface{}, middleware.Metadata, error) {
+			var metadata middleware.Metadata
+			SetRequestIDMetadata(&metadata, "request-id")
+			return &smithyhttp.Response{Response: &http.Response{StatusCode: 200}}, metadata, nil
+		}), stack)
+
+	if _, _, err := handler.Handle(context.Background(), struct{}{}); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := 2, len(tracer.spans); e != a {
+		t.Fatalf("expect %v spans, got %v", e, a)
+	}
+
+	op, attempt := tracer.spans[0], tracer.spans[1]
+	if e, a := "TestService.TestOperation", op.name; e != a {
+		t.Errorf("expect %v operation span, got %v", e, a)
+	}
+	if e, a := 1, attempt.attrs[tracing.AttemptKey]; e != a {
+		t.Errorf("expect attempt %v, got %v", e, a)
+	}
+	for _, span := range tracer.spans {
+		if !span.ended {
+			t.Errorf("expect %v span to be ended", span.name)
+		}
+		if e, a := 200, span.attrs[tracing.StatusCodeKey]; e != a {
+			t.Errorf("expect %v status code, got %v", e, a)
+		}
+		if e, a := "TestService", span.attrs[tracing.ServiceIDKey]; e != a {
+			t.Errorf("expect %v service ID, got %v", e, a)
+		}
+	}
+	if e, a := "request-id", op.attrs[tracing.RequestIDKey]; e != a {
+		t.Errorf("expect %v request ID, got %v", e, a)
+	}
+
+	for _, name := range []string{tracing.OperationDurationMetric, tracing.AttemptDurationMetric} {
+		if e, a := 1, len(meter.values[name]); e != a {
+			t.Errorf("expect %v %v recorded, got %v", e, name, a)
+		}
+	}
+}
+
+func TestAddTracingMiddleware_InitializeError(t *testing.T) {
+	tracer := &recordingTracer{}
+	meter := &recordingMeter{values: map[string][]float64{}}
+	validationErr := errors.New("invalid input")
+
+	stack := middleware.NewStack("TestOperation", smithyhttp.NewStackRequest)
+	if err := stack.Initialize.Add(middleware.InitializeMiddlewareFunc("Validation", func(
+		ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler,
+	) (middleware.InitializeOutput, middleware.Metadata, error) {
+		return middleware.InitializeOutput{}, middleware.Metadata{}, validationErr
+	}), middleware.After); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if err := stack.Initialize.Add(&RegisterServiceMetadata{
+		ServiceID:     "TestService",
+		OperationName: "TestOperation",
+	}, middleware.Before); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if err := AddTracingMiddleware(stack, tracer, meter); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	handler := middleware.DecorateHandler(middleware.HandlerFunc(
+		func(ctx context.Context, in interface{}) (interface{}, middleware.Metadata, error) {
+			t.Fatalf("expect the request not to be sent")
+			return nil, middleware.Metadata{}, nil
+		}), stack)
+
+	if _, _, err := handler.Handle(context.Background(), struct{}{}); !errors.Is(err, validationErr) {
+		t.Fatalf("expect validation error, got %v", err)
+	}
+
+	if e, a := 1, len(tracer.spans); e != a {
+		t.Fatalf("expect %v spans, got %v", e, a)
+	}
+	span := tracer.spans[0]
+	if e, a := "TestService.TestOperation", span.name; e != a {
+		t.Errorf("expect %v operation span, got %v", e, a)
+	}
+	if !errors.Is(span.err, validationErr) {
+		t.Errorf("expect validation error recorded on the span, got %v", span.err)
+	}
+	if !span.ended {
+		t.Errorf("expect the span to be ended")
+	}
+	if e, a := 1, len(meter.values[tracing.OperationDurationMetric]); e != a {
+		t.Errorf("expect %v operation duration recorded, got %v", e, a)
+	}
+}
@@ -0,0 +1,92 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"strings"
+
+	"github.com/strick-j/cybr-sdk-alpha/cybr"
+	"github.com/strick-j/smithy-go/middleware"
+	smithyhttp "github.com/strick-j/smithy-go/transport/http"
+)
+
+const userAgentHeader = "User-Agent"
+
+// RequestUserAgent is a Smithy BuildMiddleware that sets the User-Agent
+// header of the request, identifying the SDK, the Go runtime, the service
+// operation invoked, and optionally the application sending the request, e.g.
+//
+//	cybr-sdk-alpha/0.0.0-dev lang/go#1.21.4 os/linux#amd64 api/privilege-cloud#ListAccounts app/my-tool
+//
+// The value is appended to a User-Agent already set on the request.
+type RequestUserAgent struct {
+	// The ID of the application sending the request, the app/ component of
+	// the User-Agent. Not included if empty.
+	AppID string
+}
+
+// ID returns the middleware identifier.
+func (u *RequestUserAgent) ID() string {
+	return "UserAgent"
+}
+
+// HandleBuild sets the User-Agent header of the request.
+func (u *RequestUserAgent) HandleBuild(ctx context.Context, in middleware.BuildInput, next middleware.BuildHandler) (
+	out middleware.BuildOutput, metadata middleware.Metadata, err error,
+) {
+	req, ok := in.Request.(*smithyhttp.Request)
+	if !ok {
+		return out, metadata, fmt.Errorf("unknown transport type %T", in.Request)
+	}
+
+	ua := userAgent(GetServiceID(ctx), GetOperationName(ctx), u.AppID)
+	if current := req.Header.Get(userAgentHeader); len(current) != 0 {
+		ua = current + " " + ua
+	}
+	req.Header.Set(userAgentHeader, ua)
+
+	return next.HandleBuild(ctx, in)
+}
+
+// userAgent returns the User-Agent of a request of the service operation.
+func userAgent(serviceID, operation, appID string) string {
+	var sb strings.Builder
+	sb.WriteString(cybr.SDKName + "/" + cybr.SDKVersion)
+	sb.WriteString(" lang/go#" + sanitizeUserAgentValue(strings.TrimPrefix(runtime.Version(), "go")))
+	sb.WriteString(" os/" + runtime.GOOS + "#" + runtime.GOARCH)
+
+	if len(serviceID) != 0 {
+		sb.WriteString(" api/" + sanitizeUserAgentValue(strings.ToLower(serviceID)))
+		if len(operation) != 0 {
+			sb.WriteString("#" + sanitizeUserAgentValue(operation))
+		}
+	}
+	if len(appID) != 0 {
+		sb.WriteString(" app/" + sanitizeUserAgentValue(appID))
+	}
+
+	return sb.String()
+}
+
+// sanitizeUserAgentValue replaces the characters of v that are not valid in
+// a User-Agent product token, or that separate the components of the SDK's
+// User-Agent, with a dash.
+func sanitizeUserAgentValue(v string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		case strings.ContainsRune("!$%&'*+-.^_`|~", r):
+			return r
+		default:
+			return '-'
+		}
+	}, v)
+}
+
+// AddUserAgentMiddleware adds the RequestUserAgent middleware to the stack,
+// with the ID of the application sending the requests, if any.
+func AddUserAgentMiddleware(stack *middleware.Stack, appID string) error {
+	return stack.Build.Add(&RequestUserAgent{AppID: appID}, middleware.After)
+}
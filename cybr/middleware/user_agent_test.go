@@ -0,0 +1,73 @@
+package middleware
+
+import (
+	"context"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/strick-j/cybr-sdk-alpha/cybr"
+	"github.com/strick-j/smithy-go/middleware"
+	smithyhttp "github.com/strick-j/smithy-go/transport/http"
+)
+
+func TestRequestUserAgent(t *testing.T) {
+	goVersion := strings.TrimPrefix(runtime.Version(), "go")
+	sdkAgent := cybr.SDKName + "/" + cybr.SDKVersion + " lang/go#" + sanitizeUserAgentValue(goVersion) +
+		" os/" + runtime.GOOS + "#" + runtime.GOARCH
+
+	cases := map[string]struct {
+		ServiceID string
+		Operation string
+		AppID     string
+		Current   string
+		Expect    string
+	}{
+		"sdk only": {
+			Expect: sdkAgent,
+		},
+		"service operation": {
+			ServiceID: "Privilege Cloud",
+			Operation: "ListAccounts",
+			Expect:    sdkAgent + " api/privilege-cloud#ListAccounts",
+		},
+		"app id": {
+			ServiceID: "Generic",
+			Operation: "GetPlatformToken",
+			AppID:     "password rotation/2",
+			Expect:    sdkAgent + " api/generic#GetPlatformToken app/password-rotation-2",
+		},
+		"appended to current": {
+			AppID:   "my-tool",
+			Current: "custom/1.0",
+			Expect:  "custom/1.0 " + sdkAgent + " app/my-tool",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := SetServiceID(context.Background(), c.ServiceID)
+			ctx = setOperationName(ctx, c.Operation)
+
+			req := smithyhttp.NewStackRequest().(*smithyhttp.Request)
+			if len(c.Current) != 0 {
+				req.Header.Set("User-Agent", c.Current)
+			}
+
+			m := &RequestUserAgent{AppID: c.AppID}
+			_, _, err := m.HandleBuild(ctx, middleware.BuildInput{Request: req},
+				middleware.BuildHandlerFunc(func(ctx context.Context, in middleware.BuildInput) (
+					out middleware.BuildOutput, metadata middleware.Metadata, err error,
+				) {
+					return out, metadata, nil
+				}),
+			)
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.Expect, req.Header.Get("User-Agent"); e != a {
+				t.Errorf("expect User-Agent\n%v\ngot\n%v", e, a)
+			}
+		})
+	}
+}
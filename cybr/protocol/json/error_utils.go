@@ -0,0 +1,54 @@
+package json
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ErrorComponents represents the error response fields
+// that will be deserialized from a json error response body
+type ErrorComponents struct {
+	Code    string
+	Message string
+}
+
+// errorCodeKeys and errorMessageKeys are the keys, in order of precedence,
+// the services return the error code and message in. The CyberArk REST APIs
+// use ErrorCode and ErrorMessage, the identity and OAuth APIs use the others.
+var (
+	errorCodeKeys    = []string{"errorcode", "code", "error"}
+	errorMessageKeys = []string{"errormessage", "message", "error_description", "details"}
+)
+
+// GetErrorResponseComponents returns the error fields from a json error
+// response body. The keys of the body are matched case-insensitively. Empty
+// components are returned for an empty body.
+func GetErrorResponseComponents(r io.Reader) (ErrorComponents, error) {
+	var body map[string]interface{}
+	if err := json.NewDecoder(r).Decode(&body); err != nil && err != io.EOF {
+		return ErrorComponents{}, fmt.Errorf("error while deserializing json error response: %w", err)
+	}
+
+	fields := make(map[string]string, len(body))
+	for k, v := range body {
+		if s, ok := v.(string); ok {
+			fields[strings.ToLower(k)] = s
+		}
+	}
+
+	return ErrorComponents{
+		Code:    firstField(fields, errorCodeKeys),
+		Message: firstField(fields, errorMessageKeys),
+	}, nil
+}
+
+func firstField(fields map[string]string, keys []string) string {
+	for _, k := range keys {
+		if v := fields[k]; len(v) != 0 {
+			return v
+		}
+	}
+	return ""
+}
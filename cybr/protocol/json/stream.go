@@ -0,0 +1,77 @@
+package json
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// NewBase64MemberReader returns a reader of the JSON object document with the
+// key set to the base64 encoded content of r, e.g. a file uploaded as a member
+// of a JSON request body. The content is encoded as the returned reader is
+// read, so it is never buffered in memory as a whole.
+//
+// The document must be an encoded JSON object that does not have the key.
+func NewBase64MemberReader(document []byte, key string, r io.Reader) (io.Reader, error) {
+	document = bytes.TrimSpace(document)
+	if len(document) < 2 || document[0] != '{' || document[len(document)-1] != '}' {
+		return nil, fmt.Errorf("expected a JSON object document to add the %s member to", key)
+	}
+
+	encodedKey, err := json.Marshal(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode member key %s, %w", key, err)
+	}
+
+	var prefix bytes.Buffer
+	members := document[:len(document)-1]
+	prefix.Write(members)
+	if len(bytes.TrimSpace(members[1:])) != 0 {
+		prefix.WriteByte(',')
+	}
+	prefix.Write(encodedKey)
+	prefix.WriteString(`:"`)
+
+	return io.MultiReader(&prefix, &base64Reader{src: r}, strings.NewReader(`"}`)), nil
+}
+
+// base64Reader reads the standard base64 encoding of its source, encoding
+// the source in chunks as it is read.
+type base64Reader struct {
+	src io.Reader
+	err error
+
+	// in is a multiple of 3 bytes long, so only the last chunk read from the
+	// source is padded.
+	in      [3 * 1024]byte
+	out     [4 * 1024]byte
+	pending []byte
+}
+
+func (r *base64Reader) Read(p []byte) (int, error) {
+	for len(r.pending) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+
+		n, err := io.ReadFull(r.src, r.in[:])
+		if n > 0 {
+			base64.StdEncoding.Encode(r.out[:], r.in[:n])
+			r.pending = r.out[:base64.StdEncoding.EncodedLen(n)]
+		}
+		switch err {
+		case nil:
+		case io.EOF, io.ErrUnexpectedEOF:
+			r.err = io.EOF
+		default:
+			r.err = err
+		}
+	}
+
+	n := copy(p, r.pending)
+	r.pending = r.pending[n:]
+	return n, nil
+}
@@ -0,0 +1,80 @@
+package json
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+	"testing/iotest"
+)
+
+func TestNewBase64MemberReader(t *testing.T) {
+	large := bytes.Repeat([]byte("0123456789"), 1000)
+
+	cases := map[string]struct {
+		Document []byte
+		Content  []byte
+		Expect   string
+	}{
+		"empty document": {
+			Document: []byte(`{}`),
+			Content:  []byte("PK\x03\x04"),
+			Expect:   `{"ImportFile":"UEsDBA=="}`,
+		},
+		"document members": {
+			Document: []byte(`{"Name":"Unix"}`),
+			Content:  []byte("PK\x03\x04"),
+			Expect:   `{"Name":"Unix","ImportFile":"UEsDBA=="}`,
+		},
+		"empty content": {
+			Document: []byte(`{}`),
+			Expect:   `{"ImportFile":""}`,
+		},
+		"multiple chunks": {
+			Document: []byte(`{}`),
+			Content:  large,
+			Expect:   `{"ImportFile":"` + base64.StdEncoding.EncodeToString(large) + `"}`,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			r, err := NewBase64MemberReader(c.Document, "ImportFile", iotest.HalfReader(bytes.NewReader(c.Content)))
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			b, err := io.ReadAll(iotest.OneByteReader(r))
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.Expect, string(b); e != a {
+				t.Errorf("expect %v, got %v", e, a)
+			}
+
+			var v struct{ ImportFile []byte }
+			if err := json.Unmarshal(b, &v); err != nil {
+				t.Fatalf("expect valid JSON, got %v", err)
+			}
+			if !bytes.Equal(c.Content, v.ImportFile) {
+				t.Errorf("expect decoded content to match")
+			}
+		})
+	}
+}
+
+func TestNewBase64MemberReader_Errors(t *testing.T) {
+	if _, err := NewBase64MemberReader([]byte(`[]`), "ImportFile", bytes.NewReader(nil)); err == nil {
+		t.Errorf("expect error for a document that is not an object, got none")
+	}
+
+	readErr := errors.New("read failed")
+	r, err := NewBase64MemberReader([]byte(`{}`), "ImportFile", iotest.ErrReader(readErr))
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if _, err := io.ReadAll(r); !errors.Is(err, readErr) {
+		t.Errorf("expect %v error, got %v", readErr, err)
+	}
+}
@@ -0,0 +1,91 @@
+// Package multipart provides the encoding of multipart/form-data request
+// bodies, streaming the content of the file parts as the body is read.
+package multipart
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+)
+
+// Part is a part of a multipart/form-data body, either a form field or a
+// file.
+type Part struct {
+	// The name of the form field of the part.
+	Name string
+
+	// The value of a form field part. Ignored if Content is set.
+	Value string
+
+	// The content of a file part, read as the body is read.
+	Content io.Reader
+
+	// The file name of a file part. Defaults to Name.
+	FileName string
+
+	// The content type of a file part. Defaults to application/octet-stream.
+	ContentType string
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// NewFormReader returns a reader of the multipart/form-data body of the
+// parts, along with the content type of the body, including its boundary.
+//
+// The content of the file parts is read as the returned reader is read, so
+// it is never buffered in memory as a whole. Only the headers of the parts,
+// and the values of the form fields, are buffered.
+func NewFormReader(parts []Part) (io.Reader, string, error) {
+	var segment bytes.Buffer
+	w := multipart.NewWriter(&segment)
+
+	var readers []io.Reader
+	flush := func() {
+		if segment.Len() == 0 {
+			return
+		}
+		readers = append(readers, bytes.NewReader(bytes.Clone(segment.Bytes())))
+		segment.Reset()
+	}
+
+	for _, p := range parts {
+		if p.Content == nil {
+			fw, err := w.CreateFormField(p.Name)
+			if err != nil {
+				return nil, "", fmt.Errorf("failed to create form field %s, %w", p.Name, err)
+			}
+			if _, err := io.WriteString(fw, p.Value); err != nil {
+				return nil, "", fmt.Errorf("failed to write form field %s, %w", p.Name, err)
+			}
+			continue
+		}
+
+		fileName, contentType := p.FileName, p.ContentType
+		if len(fileName) == 0 {
+			fileName = p.Name
+		}
+		if len(contentType) == 0 {
+			contentType = "application/octet-stream"
+		}
+
+		h := make(textproto.MIMEHeader)
+		h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`,
+			quoteEscaper.Replace(p.Name), quoteEscaper.Replace(fileName)))
+		h.Set("Content-Type", contentType)
+		if _, err := w.CreatePart(h); err != nil {
+			return nil, "", fmt.Errorf("failed to create file part %s, %w", p.Name, err)
+		}
+		flush()
+		readers = append(readers, p.Content)
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, "", fmt.Errorf("failed to close multipart body, %w", err)
+	}
+	flush()
+
+	return io.MultiReader(readers...), w.FormDataContentType(), nil
+}
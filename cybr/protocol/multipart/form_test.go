@@ -0,0 +1,108 @@
+package multipart
+
+import (
+	"bytes"
+	"io"
+	"mime"
+	"mime/multipart"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestNewFormReader(t *testing.T) {
+	csv := strings.Repeat("userName,address,safeName\n", 4096)
+
+	body, contentType, err := NewFormReader([]Part{
+		{Name: "safeName", Value: "Operations"},
+		{Name: "file", Content: iotest.HalfReader(strings.NewReader(csv)), FileName: "accounts.csv", ContentType: "text/csv"},
+		{Name: "package", Content: bytes.NewReader([]byte("PK\x03\x04"))},
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		t.Fatalf("expect valid content type, got %v", err)
+	}
+	if e, a := "multipart/form-data", mediaType; e != a {
+		t.Fatalf("expect media type %v, got %v", e, a)
+	}
+
+	form, err := multipart.NewReader(body, params["boundary"]).ReadForm(1 << 20)
+	if err != nil {
+		t.Fatalf("expect valid multipart body, got %v", err)
+	}
+
+	if e, a := []string{"Operations"}, form.Value["safeName"]; len(a) != 1 || e[0] != a[0] {
+		t.Errorf("expect safeName %v, got %v", e, a)
+	}
+
+	cases := map[string]struct {
+		FileName    string
+		ContentType string
+		Content     string
+	}{
+		"file":    {FileName: "accounts.csv", ContentType: "text/csv", Content: csv},
+		"package": {FileName: "package", ContentType: "application/octet-stream", Content: "PK\x03\x04"},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			files := form.File[name]
+			if len(files) != 1 {
+				t.Fatalf("expect 1 file, got %v", len(files))
+			}
+			if e, a := c.FileName, files[0].Filename; e != a {
+				t.Errorf("expect file name %v, got %v", e, a)
+			}
+			if e, a := c.ContentType, files[0].Header.Get("Content-Type"); e != a {
+				t.Errorf("expect content type %v, got %v", e, a)
+			}
+			f, err := files[0].Open()
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			defer f.Close()
+			b, _ := io.ReadAll(f)
+			if e, a := c.Content, string(b); e != a {
+				t.Errorf("expect content of %v bytes, got %v bytes", len(e), len(a))
+			}
+		})
+	}
+}
+
+// endlessReader reads an endless stream of zeros, failing the test if more
+// than limit bytes are read.
+type endlessReader struct {
+	t     *testing.T
+	read  int
+	limit int
+}
+
+func (r *endlessReader) Read(p []byte) (int, error) {
+	r.read += len(p)
+	if r.read > r.limit {
+		r.t.Fatalf("expect content to be read lazily, read %v bytes", r.read)
+	}
+	return len(p), nil
+}
+
+func TestNewFormReader_Lazy(t *testing.T) {
+	content := &endlessReader{t: t, limit: 1 << 20}
+
+	body, _, err := NewFormReader([]Part{{Name: "file", Content: content}})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if content.read != 0 {
+		t.Fatalf("expect no content read before the body is read, read %v bytes", content.read)
+	}
+
+	if _, err := io.CopyN(io.Discard, body, 64*1024); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if content.read > 128*1024 {
+		t.Errorf("expect content to be read as the body is read, read %v bytes", content.read)
+	}
+}
@@ -0,0 +1,120 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+)
+
+// Defaults for the ClientRateLimiter.
+const (
+	// DefaultRate is the default number of requests per second allowed for
+	// each subdomain and service.
+	DefaultRate = 10.0
+
+	// DefaultBurst is the default number of requests that may be sent at once
+	// for each subdomain and service.
+	DefaultBurst = 10
+
+	// DefaultMinRate is the default rate, in requests per second, that
+	// throttling responses will not reduce the rate below.
+	DefaultMinRate = 0.5
+)
+
+// Options provides the configuration of the ClientRateLimiter.
+type Options struct {
+	// The number of requests per second allowed for each subdomain and
+	// service. Defaults to DefaultRate if 0 or less.
+	Rate float64
+
+	// The maximum number of requests that may be sent at once for each
+	// subdomain and service. Defaults to DefaultBurst if 0 or less.
+	Burst int
+
+	// The rate, in requests per second, that throttling responses will not
+	// reduce the rate below. Defaults to DefaultMinRate.
+	MinRate float64
+}
+
+type bucketKey struct {
+	subdomain string
+	serviceID string
+}
+
+// ClientRateLimiter provides a client side rate limiter with a token bucket
+// for each CyberArk tenant subdomain and service. ClientRateLimiter
+// satisfies the cybr.RateLimiter interface.
+//
+// A single ClientRateLimiter should be shared by all API clients sending
+// requests to the same tenant, so that the combined rate of the clients is
+// limited. Set the ClientRateLimiter on the cybr.Config the API clients are
+// created from to share it.
+//
+//	cfg.RateLimiter = ratelimit.NewClientRateLimiter(func(o *ratelimit.Options) {
+//		o.Rate = 5
+//		o.Burst = 20
+//	})
+type ClientRateLimiter struct {
+	options Options
+
+	mu      sync.Mutex
+	buckets map[bucketKey]*TokenBucket
+}
+
+// NewClientRateLimiter returns an initialized ClientRateLimiter. Provide
+// functional options to configure the rate and burst of the token buckets.
+func NewClientRateLimiter(optFns ...func(*Options)) *ClientRateLimiter {
+	options := Options{
+		Rate:    DefaultRate,
+		Burst:   DefaultBurst,
+		MinRate: DefaultMinRate,
+	}
+	for _, fn := range optFns {
+		fn(&options)
+	}
+	if !(options.Rate > 0) {
+		options.Rate = DefaultRate
+	}
+	if options.Burst <= 0 {
+		options.Burst = DefaultBurst
+	}
+
+	return &ClientRateLimiter{
+		options: options,
+		buckets: map[bucketKey]*TokenBucket{},
+	}
+}
+
+// GetToken blocks until a request to the subdomain and service is allowed
+// to be sent, or the context is canceled.
+func (l *ClientRateLimiter) GetToken(ctx context.Context, subdomain, serviceID string) error {
+	return l.Bucket(subdomain, serviceID).Wait(ctx)
+}
+
+// ObserveResponse adapts the rate of the subdomain and service's token bucket
+// to the response received. Throttled responses reduce the rate, successful
+// responses recover the rate.
+func (l *ClientRateLimiter) ObserveResponse(subdomain, serviceID string, throttled bool) {
+	bucket := l.Bucket(subdomain, serviceID)
+	if throttled {
+		bucket.Throttled()
+		return
+	}
+	bucket.Succeeded()
+}
+
+// Bucket returns the token bucket for the subdomain and service, creating
+// it if it does not exist.
+func (l *ClientRateLimiter) Bucket(subdomain, serviceID string) *TokenBucket {
+	key := bucketKey{subdomain: subdomain, serviceID: serviceID}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if b, ok := l.buckets[key]; ok {
+		return b
+	}
+
+	b := NewTokenBucket(l.options.Rate, l.options.Burst, l.options.MinRate)
+	l.buckets[key] = b
+	return b
+}
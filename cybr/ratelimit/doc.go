@@ -0,0 +1,4 @@
+// Package ratelimit provides client side rate limiting for the SDK's API
+// clients, using token buckets keyed by CyberArk tenant subdomain and
+// service.
+package ratelimit
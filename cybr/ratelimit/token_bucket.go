@@ -0,0 +1,134 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/strick-j/cybr-sdk-alpha/internal/sdk"
+)
+
+// TokenBucket provides a concurrency safe utility for limiting the rate of
+// requests using the token bucket algorithm. Tokens are added to the bucket
+// at the bucket's current rate, up to the bucket's burst size.
+//
+// The rate of the bucket adapts to throttling responses. Each throttled
+// response reduces the rate by half, down to the bucket's minimum rate. Each
+// successful response recovers a portion of the configured rate.
+type TokenBucket struct {
+	mu sync.Mutex
+
+	maxRate float64
+	minRate float64
+	rate    float64
+	burst   float64
+
+	tokens     float64
+	lastRefill time.Time
+}
+
+// NewTokenBucket returns an initialized TokenBucket that adds tokens at the
+// rate per second provided, and holds at most burst tokens. The bucket starts
+// full. A rate or burst that is not positive defaults to DefaultRate or
+// DefaultBurst, as the bucket would otherwise either never throttle or never
+// allow a request.
+func NewTokenBucket(rate float64, burst int, minRate float64) *TokenBucket {
+	if !(rate > 0) {
+		rate = DefaultRate
+	}
+	if burst <= 0 {
+		burst = DefaultBurst
+	}
+	if minRate <= 0 || minRate > rate {
+		minRate = rate
+	}
+	return &TokenBucket{
+		maxRate:    rate,
+		minRate:    minRate,
+		rate:       rate,
+		burst:      float64(burst),
+		tokens:     float64(burst),
+		lastRefill: sdk.NowTime(),
+	}
+}
+
+// Wait blocks until a token is available, or the context is canceled. If the
+// context is canceled before a token becomes available, the reserved token is
+// returned to the bucket, and the context's error is returned.
+func (t *TokenBucket) Wait(ctx context.Context) error {
+	t.mu.Lock()
+	t.refill()
+	t.tokens--
+	var delay time.Duration
+	if t.tokens < 0 {
+		delay = time.Duration(-t.tokens / t.rate * float64(time.Second))
+	}
+	t.mu.Unlock()
+
+	if delay == 0 {
+		return nil
+	}
+
+	if err := sdk.SleepWithContext(ctx, delay); err != nil {
+		t.mu.Lock()
+		t.tokens++
+		t.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// Throttled reduces the rate of the bucket in response to a throttling
+// response from the service. The rate will not be reduced below the bucket's
+// minimum rate.
+func (t *TokenBucket) Throttled() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.refill()
+	t.rate /= 2
+	if t.rate < t.minRate {
+		t.rate = t.minRate
+	}
+}
+
+// Succeeded recovers a tenth of the bucket's configured rate after a
+// successful response, up to the configured rate.
+func (t *TokenBucket) Succeeded() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.rate == t.maxRate {
+		return
+	}
+
+	t.refill()
+	t.rate += t.maxRate / 10
+	if t.rate > t.maxRate {
+		t.rate = t.maxRate
+	}
+}
+
+// Rate returns the current rate of the bucket in tokens per second.
+func (t *TokenBucket) Rate() float64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.rate
+}
+
+// refill adds the tokens accumulated since the last refill. Must be called
+// with the lock held.
+func (t *TokenBucket) refill() {
+	now := sdk.NowTime()
+	elapsed := now.Sub(t.lastRefill)
+	if elapsed <= 0 {
+		return
+	}
+	t.lastRefill = now
+
+	t.tokens += elapsed.Seconds() * t.rate
+	if t.tokens > t.burst {
+		t.tokens = t.burst
+	}
+}
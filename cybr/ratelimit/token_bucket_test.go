@@ -0,0 +1,163 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/strick-j/cybr-sdk-alpha/internal/sdk"
+)
+
+func mockTime(t *testing.T) (advance func(time.Duration), slept *[]time.Duration) {
+	now := time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)
+	origNow, origSleep := sdk.NowTime, sdk.SleepWithContext
+	t.Cleanup(func() {
+		sdk.NowTime, sdk.SleepWithContext = origNow, origSleep
+	})
+
+	var sleeps []time.Duration
+	sdk.NowTime = func() time.Time { return now }
+	sdk.SleepWithContext = func(ctx context.Context, d time.Duration) error {
+		sleeps = append(sleeps, d)
+		return ctx.Err()
+	}
+
+	return func(d time.Duration) { now = now.Add(d) }, &sleeps
+}
+
+func TestTokenBucket_Wait(t *testing.T) {
+	advance, slept := mockTime(t)
+
+	bucket := NewTokenBucket(2, 2, 0)
+
+	for i := 0; i < 2; i++ {
+		if err := bucket.Wait(context.Background()); err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+	}
+	if len(*slept) != 0 {
+		t.Fatalf("expect burst tokens without waiting, slept %v", *slept)
+	}
+
+	if err := bucket.Wait(context.Background()); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := []time.Duration{500 * time.Millisecond}, *slept; len(a) != 1 || e[0] != a[0] {
+		t.Fatalf("expect to wait %v, got %v", e, a)
+	}
+
+	advance(2 * time.Second)
+	*slept = nil
+	if err := bucket.Wait(context.Background()); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if len(*slept) != 0 {
+		t.Errorf("expect refilled bucket not to wait, slept %v", *slept)
+	}
+}
+
+func TestTokenBucket_WaitCanceled(t *testing.T) {
+	mockTime(t)
+
+	bucket := NewTokenBucket(1, 1, 0)
+	if err := bucket.Wait(context.Background()); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := bucket.Wait(ctx); err == nil {
+		t.Fatalf("expect error, got none")
+	}
+
+	bucket.mu.Lock()
+	defer bucket.mu.Unlock()
+	if e, a := 0.0, bucket.tokens; e != a {
+		t.Errorf("expect canceled wait to return token, %v tokens, got %v", e, a)
+	}
+}
+
+func TestTokenBucket_Adapt(t *testing.T) {
+	mockTime(t)
+
+	bucket := NewTokenBucket(8, 8, 1)
+
+	bucket.Throttled()
+	if e, a := 4.0, bucket.Rate(); e != a {
+		t.Errorf("expect %v rate, got %v", e, a)
+	}
+
+	for i := 0; i < 5; i++ {
+		bucket.Throttled()
+	}
+	if e, a := 1.0, bucket.Rate(); e != a {
+		t.Errorf("expect rate floor %v, got %v", e, a)
+	}
+
+	for i := 0; i < 20; i++ {
+		bucket.Succeeded()
+	}
+	if e, a := 8.0, bucket.Rate(); e != a {
+		t.Errorf("expect rate to recover to %v, got %v", e, a)
+	}
+}
+
+func TestClientRateLimiter_BucketPerTenantService(t *testing.T) {
+	limiter := NewClientRateLimiter()
+
+	a := limiter.Bucket("tenant-a", "Generic")
+	if a != limiter.Bucket("tenant-a", "Generic") {
+		t.Errorf("expect same bucket for same subdomain and service")
+	}
+	if a == limiter.Bucket("tenant-b", "Generic") {
+		t.Errorf("expect different bucket for different subdomain")
+	}
+	if a == limiter.Bucket("tenant-a", "PrivilegeCloud") {
+		t.Errorf("expect different bucket for different service")
+	}
+
+	limiter.ObserveResponse("tenant-a", "Generic", true)
+	if e, a := DefaultRate/2, a.Rate(); e != a {
+		t.Errorf("expect %v rate, got %v", e, a)
+	}
+}
+
+func TestNewTokenBucket_InvalidConfig(t *testing.T) {
+	cases := map[string]struct {
+		Rate        float64
+		Burst       int
+		ExpectRate  float64
+		ExpectBurst int
+	}{
+		"zero rate":      {Rate: 0, Burst: 5, ExpectRate: DefaultRate, ExpectBurst: 5},
+		"negative rate":  {Rate: -1, Burst: 5, ExpectRate: DefaultRate, ExpectBurst: 5},
+		"NaN rate":       {Rate: math.NaN(), Burst: 5, ExpectRate: DefaultRate, ExpectBurst: 5},
+		"zero burst":     {Rate: 5, Burst: 0, ExpectRate: 5, ExpectBurst: DefaultBurst},
+		"negative burst": {Rate: 5, Burst: -1, ExpectRate: 5, ExpectBurst: DefaultBurst},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			bucket := NewTokenBucket(c.Rate, c.Burst, 0)
+			if e, a := c.ExpectRate, bucket.rate; e != a {
+				t.Errorf("expect rate %v, got %v", e, a)
+			}
+			if e, a := float64(c.ExpectBurst), bucket.burst; e != a {
+				t.Errorf("expect burst %v, got %v", e, a)
+			}
+		})
+		t.Run(name+" client rate limiter", func(t *testing.T) {
+			l := NewClientRateLimiter(func(o *Options) {
+				o.Rate = c.Rate
+				o.Burst = c.Burst
+			})
+			if e, a := c.ExpectRate, l.options.Rate; e != a {
+				t.Errorf("expect rate %v, got %v", e, a)
+			}
+			if e, a := c.ExpectBurst, l.options.Burst; e != a {
+				t.Errorf("expect burst %v, got %v", e, a)
+			}
+		})
+	}
+}
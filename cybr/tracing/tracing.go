@@ -0,0 +1,117 @@
+// Package tracing provides the interfaces the SDK's API clients use to
+// record traces and metrics of the operations they invoke. The interfaces are
+// intentionally small so they can be adapted to any tracing or metrics
+// library. See the github.com/strick-j/cybr-sdk-alpha/otel module for an
+// OpenTelemetry adapter.
+package tracing
+
+import (
+	"context"
+)
+
+// Attribute keys recorded on the spans and metrics of API client operations.
+const (
+	// ServiceIDKey is the ID of the service the operation was invoked on.
+	ServiceIDKey = "rpc.service"
+
+	// OperationNameKey is the name of the operation invoked.
+	OperationNameKey = "rpc.method"
+
+	// StatusCodeKey is the HTTP status code of the response.
+	StatusCodeKey = "http.response.status_code"
+
+	// RequestIDKey is the request ID returned by the service.
+	RequestIDKey = "cybr.request_id"
+
+	// AttemptKey is the number of the request attempt, starting at 1.
+	AttemptKey = "cybr.attempt"
+)
+
+// Names of the histograms recorded for API client operations.
+const (
+	// OperationDurationMetric is the duration of the operation, including all
+	// attempts, in seconds.
+	OperationDurationMetric = "cybr.client.operation.duration"
+
+	// AttemptDurationMetric is the duration of a single request attempt, in
+	// seconds.
+	AttemptDurationMetric = "cybr.client.attempt.duration"
+)
+
+// Attribute is a key value pair describing a span or metric measurement.
+// Values are expected to be a string, bool, int, int64, or float64.
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+// String returns a string Attribute.
+func String(key, value string) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+// Int returns an int Attribute.
+func Int(key string, value int) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+// Tracer starts the spans recording the operations invoked by API clients.
+type Tracer interface {
+	// StartSpan starts a span with the name and attributes provided. The
+	// returned context contains the span, so spans started from the context
+	// are children of the span.
+	StartSpan(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span)
+}
+
+// Span is a unit of work started by a Tracer. The span must be ended by
+// calling End.
+type Span interface {
+	// SetAttributes sets the attributes on the span.
+	SetAttributes(attrs ...Attribute)
+
+	// SetError records the error on the span, and marks the span as failed.
+	SetError(err error)
+
+	// End completes the span.
+	End()
+}
+
+// Meter creates the instruments used to record the metrics of operations
+// invoked by API clients.
+type Meter interface {
+	// Histogram returns a histogram instrument with the name, unit, and
+	// description provided.
+	Histogram(name, unit, description string) Histogram
+}
+
+// Histogram records a distribution of values.
+type Histogram interface {
+	// Record adds the value to the distribution with the attributes provided.
+	Record(ctx context.Context, value float64, attrs ...Attribute)
+}
+
+// NopTracer provides a Tracer that does not record spans.
+type NopTracer struct{}
+
+// StartSpan returns the context, and a span that does nothing.
+func (NopTracer) StartSpan(ctx context.Context, _ string, _ ...Attribute) (context.Context, Span) {
+	return ctx, nopSpan{}
+}
+
+type nopSpan struct{}
+
+func (nopSpan) SetAttributes(...Attribute) {}
+func (nopSpan) SetError(error)             {}
+func (nopSpan) End()                       {}
+
+// NopMeter provides a Meter whose instruments do not record values.
+type NopMeter struct{}
+
+// Histogram returns a histogram that does nothing.
+func (NopMeter) Histogram(string, string, string) Histogram {
+	return nopHistogram{}
+}
+
+type nopHistogram struct{}
+
+func (nopHistogram) Record(context.Context, float64, ...Attribute) {}
@@ -31,6 +31,10 @@ var (
 )
 
 // HTTPTransportBuilder provides a builder pattern for creating an HTTP Transport.
+//
+// The default transport routes requests through the proxy configured by the
+// HTTPS_PROXY, HTTP_PROXY, and NO_PROXY environment variables (or the
+// lowercase versions thereof).
 type HTTPTransportBuilder struct {
 	transport *http.Transport
 	dialer    *net.Dialer
@@ -79,6 +83,44 @@ func (b *HTTPTransportBuilder) WithTimeout(timeout time.Duration) *HTTPTransport
 	return cpy
 }
 
+// WithTransportOptions copies the HTTPTransportBuilder and modifies the
+// transport settings of the copy using the provided functional options. The
+// original HTTPTransportBuilder is not modified.
+//
+//	client := NewHTTPTransportBuilder().WithTransportOptions(func(tr *http.Transport) {
+//		tr.MaxIdleConns = 10
+//	})
+func (b *HTTPTransportBuilder) WithTransportOptions(opts ...func(*http.Transport)) *HTTPTransportBuilder {
+	cpy := b.clone()
+
+	tr := cpy.GetTransport()
+	for _, opt := range opts {
+		opt(tr)
+	}
+	cpy.transport = tr
+
+	return cpy
+}
+
+// WithDialerOptions copies the HTTPTransportBuilder and modifies the dialer
+// settings of the copy using the provided functional options. The transport
+// of the copy is updated to dial connections with the modified dialer.
+func (b *HTTPTransportBuilder) WithDialerOptions(opts ...func(*net.Dialer)) *HTTPTransportBuilder {
+	cpy := b.clone()
+
+	dialer := cpy.GetDialer()
+	for _, opt := range opts {
+		opt(dialer)
+	}
+	cpy.dialer = dialer
+
+	tr := cpy.GetTransport()
+	tr.DialContext = cpy.dialer.DialContext
+	cpy.transport = tr
+
+	return cpy
+}
+
 // GetTransport returns the client's transport.
 func (b *HTTPTransportBuilder) GetTransport() *http.Transport {
 	var tr *http.Transport
@@ -119,6 +161,7 @@ func defaultHTTPTransport() *http.Transport {
 	dialer := defaultDialer()
 
 	tr := &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
 		DialContext:           dialer.DialContext,
 		MaxIdleConns:          DefaultHTTPTransportMaxIdleConns,
 		MaxIdleConnsPerHost:   DefaultHTTPTransportMaxIdleConnsPerHost,
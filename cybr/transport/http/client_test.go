@@ -80,3 +80,25 @@ func TestHTTPTransportBuild_concurrent(t *testing.T) {
 		}(i, client)
 	}
 }
+
+func TestHTTPTransportBuilder_WithTransportOptions(t *testing.T) {
+	client := NewHTTPTransportBuilder()
+
+	client2 := client.WithTransportOptions(func(tr *http.Transport) {
+		tr.MaxIdleConns = 3
+	})
+
+	if e, a := DefaultHTTPTransportMaxIdleConns, client.GetTransport().MaxIdleConns; e != a {
+		t.Errorf("expected original to be unmodified %v, got %v", e, a)
+	}
+	if e, a := 3, client2.GetTransport().MaxIdleConns; e != a {
+		t.Errorf("expected %v, got %v", e, a)
+	}
+}
+
+func TestHTTPTransportBuilder_DefaultProxyFromEnvironment(t *testing.T) {
+	tr := NewHTTPTransportBuilder().GetTransport()
+	if tr.Proxy == nil {
+		t.Fatalf("expected default transport to have proxy func")
+	}
+}
@@ -0,0 +1,114 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http/httputil"
+	"sync"
+
+	"github.com/strick-j/smithy-go/logging"
+	"github.com/strick-j/smithy-go/middleware"
+	smithyhttp "github.com/strick-j/smithy-go/transport/http"
+)
+
+// RequestResponseLogger is a deserialize middleware that will log the request
+// and response HTTP messages and optionally their respective bodies. Will not
+// perform any logging if none of the options are set.
+//
+// Credentials are redacted from the logged messages. The values of the
+// headers holding credentials, e.g. Authorization with basic or bearer
+// credentials, and of the fields of JSON and form encoded bodies holding
+// credentials, e.g. client_secret and access_token, are replaced before the
+// message is logged.
+type RequestResponseLogger struct {
+	LogRequest         bool
+	LogRequestWithBody bool
+
+	LogResponse         bool
+	LogResponseWithBody bool
+
+	// The headers redacted in addition to the headers holding credentials.
+	RedactedHeaders []string
+
+	// The names of the JSON and form encoded body fields, and query string
+	// parameters, redacted in addition to the fields holding credentials.
+	// Field names are matched case-insensitively.
+	RedactedFields []string
+
+	redactorOnce sync.Once
+	redactor     *redactor
+}
+
+// ID is the middleware identifier.
+func (r *RequestResponseLogger) ID() string {
+	return "RequestResponseLogger"
+}
+
+// getRedactor returns the redactor of the logger's redacted headers and
+// fields, created on the first call.
+func (r *RequestResponseLogger) getRedactor() *redactor {
+	r.redactorOnce.Do(func() {
+		r.redactor = defaultRedactor
+		if len(r.RedactedHeaders) != 0 || len(r.RedactedFields) != 0 {
+			r.redactor = newRedactor(r.RedactedHeaders, r.RedactedFields)
+		}
+	})
+	return r.redactor
+}
+
+// HandleDeserialize will log the redacted request and response HTTP messages
+// if configured accordingly.
+func (r *RequestResponseLogger) HandleDeserialize(
+	ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler,
+) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	logger := middleware.GetLogger(ctx)
+
+	if r.LogRequest || r.LogRequestWithBody {
+		smithyRequest, ok := in.Request.(*smithyhttp.Request)
+		if !ok {
+			return out, metadata, fmt.Errorf("unknown transport type %T", in)
+		}
+
+		rc := smithyRequest.Build(ctx)
+		reqBytes, err := httputil.DumpRequestOut(rc, r.LogRequestWithBody)
+		if err != nil {
+			return out, metadata, err
+		}
+
+		logger.Logf(logging.Debug, "Request\n%v", string(r.getRedactor().redactMessage(reqBytes)))
+
+		if r.LogRequestWithBody {
+			smithyRequest, err = smithyRequest.SetStream(rc.Body)
+			if err != nil {
+				return out, metadata, err
+			}
+			in.Request = smithyRequest
+		}
+	}
+
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+
+	if (err == nil) && (r.LogResponse || r.LogResponseWithBody) {
+		smithyResponse, ok := out.RawResponse.(*smithyhttp.Response)
+		if !ok {
+			return out, metadata, fmt.Errorf("unknown transport type %T", out.RawResponse)
+		}
+
+		respBytes, err := httputil.DumpResponse(smithyResponse.Response, r.LogResponseWithBody)
+		if err != nil {
+			return out, metadata, fmt.Errorf("failed to dump response %w", err)
+		}
+
+		logger.Logf(logging.Debug, "Response\n%v", string(r.getRedactor().redactMessage(respBytes)))
+	}
+
+	return out, metadata, err
+}
+
+// AddRequestResponseLogger adds the RequestResponseLogger middleware to the
+// end of the deserialize step of the middleware stack.
+func AddRequestResponseLogger(stack *middleware.Stack, logger *RequestResponseLogger) error {
+	return stack.Deserialize.Add(logger, middleware.After)
+}
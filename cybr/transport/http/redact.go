@@ -0,0 +1,141 @@
+package http
+
+import (
+	"bytes"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// Redacted is the value sensitive values are replaced with when redacted.
+const Redacted = "[REDACTED]"
+
+// redactedHeaders are the HTTP headers whose values are redacted from logged
+// HTTP messages. The authentication scheme of Authorization header values,
+// e.g. Basic or Bearer, is preserved.
+var redactedHeaders = []string{
+	"Authorization",
+	"Proxy-Authorization",
+	"Cookie",
+	"Set-Cookie",
+}
+
+// redactedFields are the names of the JSON and form encoded body fields, and
+// query string parameters, whose values are redacted from logged HTTP
+// messages. Field names are matched case-insensitively.
+var redactedFields = []string{
+	"password",
+	"newpassword",
+	"newcredentials",
+	"client_secret",
+	"secret",
+	"access_token",
+	"refresh_token",
+	"id_token",
+	"token",
+	"keypassword",
+	"privatekey",
+}
+
+// defaultRedactor redacts the redactedHeaders and redactedFields.
+var defaultRedactor = newRedactor(nil, nil)
+
+// redactor redacts the values of headers and fields from HTTP messages. The
+// patterns matching the fields are compiled once, when the redactor is
+// created.
+type redactor struct {
+	headers    []string
+	jsonFields *regexp.Regexp
+	formFields *regexp.Regexp
+}
+
+// newRedactor returns a redactor of the redactedHeaders and redactedFields,
+// and of the additional headers and fields.
+func newRedactor(headers, fields []string) *redactor {
+	fields = append(append([]string(nil), redactedFields...), fields...)
+	names := make([]string, len(fields))
+	for i, f := range fields {
+		names[i] = regexp.QuoteMeta(f)
+	}
+	alt := strings.Join(names, "|")
+
+	return &redactor{
+		headers:    append(append([]string(nil), redactedHeaders...), headers...),
+		jsonFields: regexp.MustCompile(`("(?i:` + alt + `)"\s*:\s*)"(?:[^"\\]|\\.)*"`),
+		formFields: regexp.MustCompile(`((?:^|[?&\s])(?i:` + alt + `)=)[^&\s]*`),
+	}
+}
+
+// RedactHeader returns a copy of the header with the values of the headers
+// holding credentials, e.g. Authorization, replaced.
+func RedactHeader(header http.Header) http.Header {
+	return defaultRedactor.redactHeader(header)
+}
+
+// RedactMessage returns a copy of the dumped HTTP message, as returned by the
+// httputil Dump functions, with the values of the headers and the JSON and
+// form encoded fields holding credentials, e.g. Authorization and
+// client_secret, replaced.
+func RedactMessage(msg []byte) []byte {
+	return defaultRedactor.redactMessage(msg)
+}
+
+func (r *redactor) redactHeader(header http.Header) http.Header {
+	redacted := header.Clone()
+	for _, name := range r.headers {
+		values := redacted.Values(name)
+		for i := range values {
+			values[i] = redactHeaderValue(name, values[i])
+		}
+	}
+	return redacted
+}
+
+func redactHeaderValue(name, value string) string {
+	if strings.EqualFold(name, "Authorization") || strings.EqualFold(name, "Proxy-Authorization") {
+		if scheme, _, ok := strings.Cut(value, " "); ok {
+			return scheme + " " + Redacted
+		}
+	}
+	return Redacted
+}
+
+func (r *redactor) redactMessage(msg []byte) []byte {
+	head, body, found := bytes.Cut(msg, []byte("\r\n\r\n"))
+
+	lines := bytes.Split(head, []byte("\r\n"))
+	for i, line := range lines {
+		if i == 0 {
+			// Request or status line, may contain a query string.
+			lines[i] = r.redactFields(line)
+			continue
+		}
+
+		name, value, ok := bytes.Cut(line, []byte(":"))
+		if !ok {
+			continue
+		}
+		for _, h := range r.headers {
+			if strings.EqualFold(string(bytes.TrimSpace(name)), h) {
+				v := redactHeaderValue(h, string(bytes.TrimSpace(value)))
+				lines[i] = []byte(string(name) + ": " + v)
+				break
+			}
+		}
+	}
+
+	redacted := bytes.Join(lines, []byte("\r\n"))
+	if !found {
+		return redacted
+	}
+
+	redacted = append(redacted, []byte("\r\n\r\n")...)
+	return append(redacted, r.redactFields(body)...)
+}
+
+// redactFields replaces the values of the redacted fields in JSON and form
+// encoded content.
+func (r *redactor) redactFields(b []byte) []byte {
+	b = r.jsonFields.ReplaceAll(b, []byte(`${1}"`+Redacted+`"`))
+	return r.formFields.ReplaceAll(b, []byte(`${1}`+Redacted))
+}
@@ -4,4 +4,4 @@ package cybr
 const SDKName = "cybr-sdk-alpha"
 
 // SDKVersion is the version of this SDK
-//const SDKVersion = goModuleVersion
+const SDKVersion = goModuleVersion
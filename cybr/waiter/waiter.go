@@ -0,0 +1,230 @@
+// Package waiter provides the polling of the waiters of the API clients,
+// waiting until an asynchronous CyberArk operation completes, e.g. a CPM
+// credentials change.
+//
+// A waiter invokes an operation until one of its acceptors matches the
+// output or error of an attempt, transitioning the waiter to the Success or
+// Failure state. The attempts are delayed with an exponential backoff between
+// a minimum and maximum delay, and the waiter gives up once its maximum wait
+// duration elapses or its context is canceled.
+package waiter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/strick-j/cybr-sdk-alpha/internal/sdk"
+	smithywaiter "github.com/strick-j/smithy-go/waiter"
+)
+
+// State is the state a waiter transitions to when an Acceptor matches.
+type State int
+
+// Enumeration of waiter states.
+const (
+	// StateRetry is the state of a waiter that attempts the operation again.
+	StateRetry State = iota
+
+	// StateSuccess is the terminal state of a waiter whose operation
+	// completed successfully.
+	StateSuccess
+
+	// StateFailure is the terminal state of a waiter whose operation failed,
+	// or will not complete successfully.
+	StateFailure
+)
+
+// String returns the name of the state.
+func (s State) String() string {
+	switch s {
+	case StateRetry:
+		return "Retry"
+	case StateSuccess:
+		return "Success"
+	case StateFailure:
+		return "Failure"
+	default:
+		return fmt.Sprintf("State(%d)", int(s))
+	}
+}
+
+// Acceptor is a rule transitioning a waiter to a State when it matches the
+// output or error of an attempt of the waiter's operation.
+type Acceptor[Out any] struct {
+	// The state the waiter transitions to if the acceptor matches.
+	State State
+
+	// Matcher returns whether the acceptor matches the output, or error, of
+	// an attempt. The output is the zero value if the attempt failed.
+	Matcher func(output Out, err error) bool
+
+	// Reason optionally returns the reason of a transition to the Failure
+	// state, included in the FailureError returned by the waiter.
+	Reason func(output Out, err error) string
+}
+
+// OutputAcceptor returns an Acceptor transitioning to the state if an attempt
+// succeeds with an output matched by fn.
+func OutputAcceptor[Out any](state State, fn func(Out) bool) Acceptor[Out] {
+	return Acceptor[Out]{
+		State: state,
+		Matcher: func(output Out, err error) bool {
+			return err == nil && fn(output)
+		},
+	}
+}
+
+// ErrorAcceptor returns an Acceptor transitioning to the state if an attempt
+// fails with an error matched by fn, e.g. a not found error transitioning a
+// waiter for the deletion of a resource to the Success state.
+func ErrorAcceptor[Out any](state State, fn func(error) bool) Acceptor[Out] {
+	return Acceptor[Out]{
+		State: state,
+		Matcher: func(output Out, err error) bool {
+			return err != nil && fn(err)
+		},
+	}
+}
+
+// FailureError is the error returned by a waiter that transitioned to the
+// Failure state.
+type FailureError struct {
+	// The name of the waiter.
+	Waiter string
+
+	// The reason of the failure, if known.
+	Reason string
+
+	// The error of the attempt the failure acceptor matched, if any.
+	Err error
+}
+
+// Error returns the message of the error.
+func (e *FailureError) Error() string {
+	msg := fmt.Sprintf("%s waiter state transitioned to Failure", e.Waiter)
+	if len(e.Reason) != 0 {
+		msg += ", " + e.Reason
+	}
+	if e.Err != nil {
+		msg += fmt.Sprintf(", %v", e.Err)
+	}
+	return msg
+}
+
+// Unwrap returns the error of the attempt the failure acceptor matched.
+func (e *FailureError) Unwrap() error {
+	return e.Err
+}
+
+// Retryable returns a function deciding whether an attempt of the named
+// waiter's operation is retried, from the first of the acceptors matching
+// the attempt's output or error:
+//
+//   - StateSuccess stops the waiter,
+//   - StateFailure stops the waiter with a FailureError,
+//   - StateRetry retries the operation.
+//
+// If no acceptor matches, the operation is retried if the attempt succeeded,
+// and the waiter stops with the attempt's error otherwise.
+func Retryable[Out any](name string, acceptors ...Acceptor[Out]) func(Out, error) (bool, error) {
+	return func(output Out, err error) (bool, error) {
+		for _, a := range acceptors {
+			if !a.Matcher(output, err) {
+				continue
+			}
+			switch a.State {
+			case StateSuccess:
+				return false, nil
+			case StateFailure:
+				fe := &FailureError{Waiter: name, Err: err}
+				if a.Reason != nil {
+					fe.Reason = a.Reason(output, err)
+				}
+				return false, fe
+			default:
+				return true, nil
+			}
+		}
+		if err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+}
+
+// Options are the options of the polling of a waiter.
+type Options struct {
+	// MinDelay is the minimum amount of time to delay between attempts. Must
+	// be greater than zero, and lesser than or equal to MaxDelay.
+	MinDelay time.Duration
+
+	// MaxDelay is the maximum amount of time to delay between attempts.
+	MaxDelay time.Duration
+}
+
+// Wait invokes the attempt function of the named waiter until retryable
+// returns false, and returns the output of the last attempt. The attempts
+// are numbered from 1, and delayed with an exponential backoff between the
+// MinDelay and MaxDelay of the options.
+//
+// Returns an error if retryable returns one, if the maxWaitDur elapses before
+// the waiter stops, or if the context is canceled while waiting. The context
+// passed to the attempts is canceled once the maxWaitDur elapses.
+func Wait[Out any](ctx context.Context, name string, maxWaitDur time.Duration, options Options,
+	attempt func(ctx context.Context, attempt int64) (Out, error),
+	retryable func(ctx context.Context, output Out, err error) (bool, error),
+) (out Out, err error) {
+	var zero Out
+
+	if maxWaitDur <= 0 {
+		return zero, fmt.Errorf("maximum wait time for waiter must be greater than zero")
+	}
+	if options.MinDelay <= 0 {
+		return zero, fmt.Errorf("minimum waiter delay must be greater than zero")
+	}
+	if options.MinDelay > options.MaxDelay {
+		return zero, fmt.Errorf("minimum waiter delay %v must be lesser than or equal to maximum waiter delay of %v",
+			options.MinDelay, options.MaxDelay)
+	}
+
+	ctx, cancelFn := context.WithTimeout(ctx, maxWaitDur)
+	defer cancelFn()
+
+	remainingTime := maxWaitDur
+
+	var n int64
+	for {
+		n++
+		start := sdk.NowTime()
+
+		out, err = attempt(ctx, n)
+
+		retry, err := retryable(ctx, out, err)
+		if err != nil {
+			return zero, err
+		}
+		if !retry {
+			return out, nil
+		}
+
+		remainingTime -= sdk.NowTime().Sub(start)
+		if remainingTime <= options.MinDelay || remainingTime <= 0 {
+			break
+		}
+
+		// compute exponential backoff between waiter retries
+		delay, err := smithywaiter.ComputeDelay(n, options.MinDelay, options.MaxDelay, remainingTime)
+		if err != nil {
+			return zero, fmt.Errorf("error computing waiter delay, %w", err)
+		}
+
+		remainingTime -= delay
+		// sleep for the delay amount before invoking a request
+		if err := sdk.SleepWithContext(ctx, delay); err != nil {
+			return zero, fmt.Errorf("request cancelled while waiting, %w", err)
+		}
+	}
+
+	return zero, fmt.Errorf("exceeded max wait time for %s waiter", name)
+}
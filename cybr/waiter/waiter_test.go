@@ -0,0 +1,182 @@
+package waiter
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/strick-j/cybr-sdk-alpha/internal/sdk"
+)
+
+var errNotFound = errors.New("not found")
+
+type status struct {
+	State string
+}
+
+func TestRetryable(t *testing.T) {
+	retryable := Retryable("TestWaiter",
+		OutputAcceptor(StateSuccess, func(s *status) bool { return s.State == "done" }),
+		Acceptor[*status]{
+			State: StateFailure,
+			Matcher: func(s *status, err error) bool {
+				return err == nil && s.State == "failed"
+			},
+			Reason: func(s *status, err error) string { return "bad state" },
+		},
+		ErrorAcceptor[*status](StateRetry, func(err error) bool { return errors.Is(err, errNotFound) }),
+	)
+
+	cases := map[string]struct {
+		Output      *status
+		Err         error
+		ExpectRetry bool
+		ExpectErr   string
+	}{
+		"success":          {Output: &status{State: "done"}},
+		"failure":          {Output: &status{State: "failed"}, ExpectErr: "TestWaiter waiter state transitioned to Failure, bad state"},
+		"retry on error":   {Err: errNotFound, ExpectRetry: true},
+		"unmatched output": {Output: &status{State: "pending"}, ExpectRetry: true},
+		"unmatched error":  {Err: errors.New("boom"), ExpectErr: "boom"},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			retry, err := retryable(c.Output, c.Err)
+			if len(c.ExpectErr) != 0 {
+				if err == nil || err.Error() != c.ExpectErr {
+					t.Fatalf("expect error %q, got %v", c.ExpectErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.ExpectRetry, retry; e != a {
+				t.Errorf("expect retry %v, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestRetryable_FailureError(t *testing.T) {
+	retryable := Retryable("TestWaiter",
+		ErrorAcceptor[*status](StateFailure, func(err error) bool { return errors.Is(err, errNotFound) }),
+	)
+
+	_, err := retryable(nil, errNotFound)
+	var fe *FailureError
+	if !errors.As(err, &fe) {
+		t.Fatalf("expect FailureError, got %T", err)
+	}
+	if e, a := "TestWaiter", fe.Waiter; e != a {
+		t.Errorf("expect waiter %v, got %v", e, a)
+	}
+	if !errors.Is(err, errNotFound) {
+		t.Errorf("expect error to wrap %v", errNotFound)
+	}
+}
+
+func TestWait(t *testing.T) {
+	defer sdk.TestingUseNopSleep()()
+
+	cases := map[string]struct {
+		States         []string
+		MaxWait        time.Duration
+		Options        Options
+		ExpectAttempts int64
+		ExpectErr      string
+	}{
+		"success": {
+			States:         []string{"pending", "pending", "done"},
+			MaxWait:        time.Hour,
+			Options:        Options{MinDelay: time.Second, MaxDelay: 10 * time.Second},
+			ExpectAttempts: 3,
+		},
+		"failure": {
+			States:         []string{"pending", "failed"},
+			MaxWait:        time.Hour,
+			Options:        Options{MinDelay: time.Second, MaxDelay: 10 * time.Second},
+			ExpectAttempts: 2,
+			ExpectErr:      "waiter state transitioned to Failure",
+		},
+		"exceeded max wait": {
+			States:    []string{"pending"},
+			MaxWait:   time.Minute,
+			Options:   Options{MinDelay: 10 * time.Second, MaxDelay: 20 * time.Second},
+			ExpectErr: "exceeded max wait time for TestWaiter waiter",
+		},
+		"invalid max wait": {
+			States:    []string{"done"},
+			Options:   Options{MinDelay: time.Second, MaxDelay: 10 * time.Second},
+			ExpectErr: "maximum wait time for waiter must be greater than zero",
+		},
+		"invalid delays": {
+			States:    []string{"done"},
+			MaxWait:   time.Hour,
+			Options:   Options{MinDelay: 10 * time.Second, MaxDelay: time.Second},
+			ExpectErr: "minimum waiter delay 10s must be lesser than or equal to maximum waiter delay of 1s",
+		},
+	}
+
+	retryable := Retryable("TestWaiter",
+		OutputAcceptor(StateSuccess, func(s *status) bool { return s.State == "done" }),
+		OutputAcceptor(StateFailure, func(s *status) bool { return s.State == "failed" }),
+	)
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var attempts int64
+			out, err := Wait(context.Background(), "TestWaiter", c.MaxWait, c.Options,
+				func(ctx context.Context, attempt int64) (*status, error) {
+					attempts++
+					if e, a := attempts, attempt; e != a {
+						t.Errorf("expect attempt %v, got %v", e, a)
+					}
+					i := int(attempt) - 1
+					if i >= len(c.States) {
+						i = len(c.States) - 1
+					}
+					return &status{State: c.States[i]}, nil
+				},
+				func(ctx context.Context, output *status, err error) (bool, error) {
+					return retryable(output, err)
+				},
+			)
+			if len(c.ExpectErr) != 0 {
+				if err == nil || !strings.Contains(err.Error(), c.ExpectErr) {
+					t.Fatalf("expect error containing %q, got %v", c.ExpectErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := "done", out.State; e != a {
+				t.Errorf("expect output state %v, got %v", e, a)
+			}
+			if e, a := c.ExpectAttempts, attempts; e != a {
+				t.Errorf("expect %v attempts, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestWait_ContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	_, err := Wait(ctx, "TestWaiter", time.Hour, Options{MinDelay: time.Second, MaxDelay: time.Second},
+		func(ctx context.Context, attempt int64) (*status, error) {
+			cancel()
+			return &status{State: "pending"}, nil
+		},
+		func(ctx context.Context, output *status, err error) (bool, error) {
+			return true, nil
+		},
+	)
+	if err == nil || !errors.Is(err, context.Canceled) {
+		t.Fatalf("expect context canceled error, got %v", err)
+	}
+}
@@ -0,0 +1,531 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGeneratedClientsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		spec string
+		dir  string
+	}{
+		"privilegecloud": {
+			spec: filepath.Join("specs", "privilegecloud.json"),
+			dir:  filepath.Join("..", "..", "service", "privilegecloud"),
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			files, err := generate(tt.spec)
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			for _, f := range files {
+				actual, err := os.ReadFile(filepath.Join(tt.dir, filepath.FromSlash(f.Name)))
+				if err != nil {
+					t.Fatalf("expect %s to be generated, %v", f.Name, err)
+				}
+				if !bytes.Equal(f.Content, actual) {
+					t.Errorf("expect %s to be up to date, run go generate in %s", f.Name, tt.dir)
+				}
+			}
+		})
+	}
+}
+
+// streamingOps are operations with application/octet-stream and
+// multipart/form-data request bodies, which no Privilege Cloud operation has
+// yet, added to the Privilege Cloud spec to test their generated client.
+const streamingOps = `{
+	"/API/Test/{id}/Binary": {"post": {
+		"operationId": "UploadBinary",
+		"parameters": [{"name": "id", "in": "path", "required": true, "x-cybr-member": "Id",
+			"schema": {"type": "string"}}],
+		"requestBody": {"required": true, "x-cybr-member": "Body", "content": {
+			"application/octet-stream": {"schema": {"type": "string", "format": "binary"}}}},
+		"responses": {"204": {"description": "Uploaded."}}
+	}},
+	"/API/Test/Form": {"post": {
+		"operationId": "UploadForm",
+		"requestBody": {"required": true, "content": {
+			"multipart/form-data": {"schema": {"$ref": "#/components/schemas/UploadForm"}}}},
+		"responses": {"204": {"description": "Uploaded."}}
+	}}
+}`
+
+// streamingClientTest is run against the client generated with streamingOps.
+const streamingClientTest = `package privilegecloud
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/strick-j/cybr-sdk-alpha/cybr"
+	smithyhttp "github.com/strick-j/smithy-go/transport/http"
+)
+
+func newUploadClient(t *testing.T, read func(*http.Request) []byte, contentLength *int64, body *[]byte) *Client {
+	return New(Options{
+		Subdomain:     "example",
+		ClientLogMode: cybr.LogRequestWithBody,
+		Credentials: cybr.CredentialsProviderFunc(func(context.Context) (cybr.Credentials, error) {
+			return cybr.Credentials{SessionToken: "token"}, nil
+		}),
+		HTTPClient: smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+			*contentLength = r.ContentLength
+			*body = read(r)
+			return &http.Response{StatusCode: 204, Header: http.Header{}, Body: http.NoBody}, nil
+		}),
+	})
+}
+
+func TestUploadNotBuffered(t *testing.T) {
+	file := bytes.Repeat([]byte("PK\x03\x04"), 64*1024)
+
+	var contentLength int64
+	var body []byte
+	client := newUploadClient(t, func(r *http.Request) []byte {
+		if e, a := "application/octet-stream", r.Header.Get("Content-Type"); e != a {
+			t.Errorf("expect %v content type, got %v", e, a)
+		}
+		b, _ := io.ReadAll(r.Body)
+		return b
+	}, &contentLength, &body)
+
+	// the file is not seekable, and is streamed rather than buffered to
+	// compute the content length, or to log the request body.
+	_, err := client.UploadBinary(context.Background(), &UploadBinaryInput{
+		Id:   cybr.String("1"),
+		Body: io.MultiReader(bytes.NewReader(file)),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := int64(-1), contentLength; e != a {
+		t.Errorf("expect content length %v, got %v", e, a)
+	}
+	if !bytes.Equal(file, body) {
+		t.Errorf("expect the uploaded file to match")
+	}
+
+	client = newUploadClient(t, func(r *http.Request) []byte {
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Fatalf("expect multipart form, got %v", err)
+		}
+		if e, a := "Operations", r.FormValue("safeName"); e != a {
+			t.Errorf("expect %v safe name, got %v", e, a)
+		}
+		f, _, err := r.FormFile("file")
+		if err != nil {
+			t.Fatalf("expect file part, got %v", err)
+		}
+		b, _ := io.ReadAll(f)
+		return b
+	}, &contentLength, &body)
+
+	_, err = client.UploadForm(context.Background(), &UploadFormInput{
+		File:     io.MultiReader(bytes.NewReader(file)),
+		SafeName: cybr.String("Operations"),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := int64(-1), contentLength; e != a {
+		t.Errorf("expect content length %v, got %v", e, a)
+	}
+	if !bytes.Equal(file, body) {
+		t.Errorf("expect the uploaded file to match")
+	}
+}
+`
+
+func TestGeneratedStreamingRequests(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping building a generated client in short mode")
+	}
+	goBin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("skipping, go command not found")
+	}
+
+	serviceDir := filepath.Join("..", "..", "service", "privilegecloud")
+	s, err := loadSpec(filepath.Join("specs", "privilegecloud.json"))
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if err := json.Unmarshal([]byte(streamingOps), &s.Paths); err != nil {
+		t.Fatalf("expect valid operations, got %v", err)
+	}
+	s.Components.Schemas["UploadForm"] = &specSchema{
+		Type:     "object",
+		Required: []string{"file"},
+		Properties: map[string]*specSchema{
+			"file":     {Type: "string", Format: "binary"},
+			"safeName": {Type: "string"},
+		},
+	}
+	svc, err := buildService(s)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	files, err := render(svc)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	// The client is built in a directory of the service, ignored by the go
+	// command's ./... pattern, so it can import the service's internal and
+	// types packages.
+	dir, err := os.MkdirTemp(serviceDir, "_streaming")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	entries, err := os.ReadDir(serviceDir)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".go") || strings.HasSuffix(e.Name(), "_test.go") {
+			continue
+		}
+		b, err := os.ReadFile(filepath.Join(serviceDir, e.Name()))
+		if err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, e.Name()), b, 0644); err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+	}
+	for _, f := range files {
+		if strings.Contains(f.Name, "/") {
+			continue // the operations only use the service's existing types
+		}
+		if err := os.WriteFile(filepath.Join(dir, f.Name), f.Content, 0644); err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(dir, "upload_test.go"), []byte(streamingClientTest), 0644); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	cmd := exec.Command(goBin, "test", "-count=1", ".")
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("expect generated client test to pass, %v\n%s", err, out)
+	}
+}
+
+func TestGoName(t *testing.T) {
+	cases := map[string]string{
+		"id":                "Id",
+		"platformId":        "PlatformId",
+		"managingCPM":       "ManagingCPM",
+		"error_description": "ErrorDescription",
+		"x-request-id":      "XRequestId",
+	}
+
+	for input, expect := range cases {
+		if actual := goName(input); actual != expect {
+			t.Errorf("expect %s for %s, got %s", expect, input, actual)
+		}
+	}
+}
+
+func TestServerBasePath(t *testing.T) {
+	cases := map[string]struct {
+		url    string
+		expect string
+		err    bool
+	}{
+		"templated host": {
+			url:    "https://{subdomain}.privilegecloud.{domain}/PasswordVault",
+			expect: "/PasswordVault",
+		},
+		"trailing slash": {
+			url:    "https://{subdomain}.{domain}/api/",
+			expect: "/api",
+		},
+		"no path": {
+			url: "https://{subdomain}.{domain}",
+		},
+		"no scheme": {
+			url: "{subdomain}.{domain}/api",
+			err: true,
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			actual, err := serverBasePath(tt.url)
+			if tt.err {
+				if err == nil {
+					t.Fatalf("expect error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if actual != tt.expect {
+				t.Errorf("expect %q, got %q", tt.expect, actual)
+			}
+		})
+	}
+}
+
+func TestBuildRequestBody(t *testing.T) {
+	const schemas = `{
+		"Form": {"type": "object", "required": ["file"], "properties": {
+			"file": {"type": "string", "format": "binary"},
+			"safeName": {"type": "string"},
+			"overwrite": {"type": "boolean"}
+		}},
+		"Package": {"type": "object", "properties": {
+			"Name": {"type": "string"},
+			"ImportFile": {"type": "string", "format": "binary"}
+		}},
+		"Nested": {"type": "object", "properties": {
+			"package": {"$ref": "#/components/schemas/Package"}
+		}},
+		"BadForm": {"type": "object", "properties": {
+			"tags": {"type": "array", "items": {"type": "string"}}
+		}}
+	}`
+
+	cases := map[string]struct {
+		body   string
+		expect []string
+		err    bool
+	}{
+		"binary": {
+			body: `{"required": true, "x-cybr-member": "Body", "content": {
+				"application/octet-stream": {"schema": {"type": "string", "format": "binary"}}}}`,
+			expect: []string{
+				"Body io.Reader",
+				`restEncoder.SetHeader("Content-Type").String("application/octet-stream")`,
+				"request.SetStream(input.Body)",
+				"addStreamingRequestResponseLogging(stack, options)",
+			},
+		},
+		"multipart": {
+			body: `{"required": true, "content": {
+				"multipart/form-data": {"schema": {"$ref": "#/components/schemas/Form"}}}}`,
+			expect: []string{
+				"File io.Reader",
+				"cybrmultipart.NewFormReader(cybrRestjson_serializeOpPartsUploadInput(input))",
+				`cybrmultipart.Part{Name: "file", Content: v.File}`,
+				`cybrmultipart.Part{Name: "overwrite", Value: strconv.FormatBool(*v.Overwrite)}`,
+				"addStreamingRequestResponseLogging(stack, options)",
+			},
+		},
+		"json binary member": {
+			body: `{"required": true, "content": {
+				"application/json": {"schema": {"$ref": "#/components/schemas/Package"}}}}`,
+			expect: []string{
+				"ImportFile io.Reader",
+				`cybrjson.NewBase64MemberReader(jsonEncoder.Bytes(), "ImportFile", input.ImportFile)`,
+			},
+		},
+		"binary member of a nested structure": {
+			body: `{"content": {
+				"application/json": {"schema": {"$ref": "#/components/schemas/Nested"}}}}`,
+			err: true,
+		},
+		"binary without member": {
+			body: `{"content": {
+				"application/octet-stream": {"schema": {"type": "string", "format": "binary"}}}}`,
+			err: true,
+		},
+		"multipart list": {
+			body: `{"content": {
+				"multipart/form-data": {"schema": {"$ref": "#/components/schemas/BadForm"}}}}`,
+			err: true,
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			doc := `{
+				"servers": [{"url": "https://{subdomain}.{domain}/api"}],
+				"x-cybr-service": {"serviceId": "Test", "package": "test"},
+				"paths": {"/Upload": {"post": {
+					"operationId": "Upload",
+					"requestBody": ` + tt.body + `,
+					"responses": {"204": {"description": "Uploaded."}}
+				}}},
+				"components": {"schemas": ` + schemas + `}
+			}`
+			var s spec
+			if err := json.Unmarshal([]byte(doc), &s); err != nil {
+				t.Fatalf("expect valid spec, got %v", err)
+			}
+
+			svc, err := buildService(&s)
+			if tt.err {
+				if err == nil {
+					t.Fatalf("expect error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			files, err := render(svc)
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			var all []byte
+			for _, f := range files {
+				all = append(all, f.Content...)
+			}
+			for _, expect := range tt.expect {
+				if !bytes.Contains(all, []byte(expect)) {
+					t.Errorf("expect generated code to contain %s", expect)
+				}
+			}
+		})
+	}
+}
+
+func TestBuildAlternativeResponse(t *testing.T) {
+	cases := map[string]struct {
+		response string
+		expect   []string
+		err      bool
+	}{
+		"json or binary": {
+			response: `{"x-cybr-member": "File", "content": {
+				"application/json": {"schema": {"$ref": "#/components/schemas/Link"}},
+				"application/octet-stream": {"schema": {"type": "string", "format": "binary"}}}}`,
+			expect: []string{
+				"File []byte",
+				"Url *string",
+				`restEncoder.SetHeader("Accept").String("application/json, application/octet-stream")`,
+				`mime.ParseMediaType(response.Header.Get("Content-Type")); mediaType != "application/json"`,
+				"output.File, err = io.ReadAll(response.Body)",
+				"addStreamingRequestResponseLogging(stack, options)",
+			},
+		},
+		"binary without member": {
+			response: `{"content": {
+				"application/json": {"schema": {"$ref": "#/components/schemas/Link"}},
+				"application/octet-stream": {"schema": {"type": "string", "format": "binary"}}}}`,
+			err: true,
+		},
+		"json payload": {
+			response: `{"x-cybr-member": "File", "content": {
+				"application/json": {"schema": {"type": "string"}},
+				"application/octet-stream": {"schema": {"type": "string", "format": "binary"}}}}`,
+			err: true,
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			doc := `{
+				"servers": [{"url": "https://{subdomain}.{domain}/api"}],
+				"x-cybr-service": {"serviceId": "Test", "package": "test"},
+				"paths": {"/Download": {"post": {
+					"operationId": "Download",
+					"responses": {"200": ` + tt.response + `}
+				}}},
+				"components": {"schemas": {"Link": {"type": "object", "properties": {
+					"url": {"type": "string"}
+				}}}}
+			}`
+			var s spec
+			if err := json.Unmarshal([]byte(doc), &s); err != nil {
+				t.Fatalf("expect valid spec, got %v", err)
+			}
+
+			svc, err := buildService(&s)
+			if tt.err {
+				if err == nil {
+					t.Fatalf("expect error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			files, err := render(svc)
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			var all []byte
+			for _, f := range files {
+				all = append(all, f.Content...)
+			}
+			for _, expect := range tt.expect {
+				if !bytes.Contains(all, []byte(expect)) {
+					t.Errorf("expect generated code to contain %s", expect)
+				}
+			}
+		})
+	}
+}
+
+func TestBuildSensitiveOutput(t *testing.T) {
+	cases := map[string]struct {
+		sensitive string
+		expect    string
+	}{
+		"sensitive": {
+			sensitive: `"x-cybr-sensitive-output": true,`,
+			expect:    "addSensitiveOutputRequestResponseLogging(stack, options)",
+		},
+		"not sensitive": {
+			expect: "addRequestResponseLogging(stack, options)",
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			doc := `{
+				"servers": [{"url": "https://{subdomain}.{domain}/api"}],
+				"x-cybr-service": {"serviceId": "Test", "package": "test"},
+				"paths": {"/Secret": {"get": {
+					"operationId": "GetSecret",
+					` + tt.sensitive + `
+					"responses": {"200": {"x-cybr-member": "Secret", "content": {
+						"application/json": {"schema": {"type": "string"}}}}}
+				}}}
+			}`
+			var s spec
+			if err := json.Unmarshal([]byte(doc), &s); err != nil {
+				t.Fatalf("expect valid spec, got %v", err)
+			}
+
+			svc, err := buildService(&s)
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			files, err := render(svc)
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			var all []byte
+			for _, f := range files {
+				all = append(all, f.Content...)
+			}
+			if !bytes.Contains(all, []byte("err = "+tt.expect)) {
+				t.Errorf("expect generated code to contain %s", tt.expect)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+// Command codegen generates the operations of a service client from an
+// OpenAPI document of the service's REST API.
+//
+// The generator emits the typed operation inputs and outputs, the JSON
+// serializers and deserializers, the mapping of error responses to typed
+// errors, the paginators and the input validation of the operations. The
+// client itself, e.g. its options and endpoint resolution, is not generated.
+//
+// Usage:
+//
+//	go run ./internal/codegen -spec internal/codegen/specs/privilegecloud.json -out service/privilegecloud
+//
+// The specs are OpenAPI 3 documents, extended with x-cybr properties for what
+// OpenAPI does not describe, e.g. the x-cybr-pagination of the list
+// operations. See spec.go for the supported subset of OpenAPI.
+//
+// Binary bodies, strings of the binary format, are streamed rather than read
+// into memory. An application/octet-stream request body is read from an
+// io.Reader input member, and a multipart/form-data body is encoded from the
+// form fields and files of an object schema as it is sent. An
+// application/octet-stream response body is returned as an io.ReadCloser
+// output member, which the caller must close. A response that is either a
+// JSON object or a binary body, depending on its Content-Type, is read into
+// the members of the object or a []byte output member.
+//
+// No operation of the Privilege Cloud spec has an application/octet-stream or
+// multipart/form-data request body yet, the generated client of those bodies
+// is tested with operations added to the spec by the generator's tests.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	specFile := flag.String("spec", "", "the OpenAPI document of the service")
+	outDir := flag.String("out", ".", "the package directory of the service client")
+	flag.Parse()
+
+	if len(*specFile) == 0 {
+		log.Fatalf("the -spec flag is required")
+	}
+
+	files, err := generate(*specFile)
+	if err != nil {
+		log.Fatalf("failed to generate %s, %v", *specFile, err)
+	}
+	if err := writeFiles(*outDir, files); err != nil {
+		log.Fatalf("failed to write generated files, %v", err)
+	}
+}
+
+// generate returns the generated files of the service described by the spec.
+func generate(specFile string) ([]generatedFile, error) {
+	s, err := loadSpec(specFile)
+	if err != nil {
+		return nil, err
+	}
+	svc, err := buildService(s)
+	if err != nil {
+		return nil, err
+	}
+	return render(svc)
+}
+
+// writeFiles writes the generated files to dir, removing the previously
+// generated operation files that were not generated again, e.g. of the
+// operations removed from the spec.
+func writeFiles(dir string, files []generatedFile) error {
+	written := map[string]bool{}
+	for _, f := range files {
+		filename := filepath.Join(dir, filepath.FromSlash(f.Name))
+		if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(filename, f.Content, 0644); err != nil {
+			return err
+		}
+		written[filename] = true
+	}
+
+	stale, err := filepath.Glob(filepath.Join(dir, "api_op_*.go"))
+	if err != nil {
+		return err
+	}
+	for _, filename := range stale {
+		if written[filename] {
+			continue
+		}
+		b, err := os.ReadFile(filename)
+		if err != nil {
+			return err
+		}
+		if !bytes.HasPrefix(b, []byte(generatedNote)) {
+			continue
+		}
+		if err := os.Remove(filename); err != nil {
+			return fmt.Errorf("failed to remove stale %s, %w", filename, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,861 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// kind is the kind of the Go type of a member.
+type kind int
+
+const (
+	kindString kind = iota
+	kindBool
+	kindInt32
+	kindInt64
+	kindFloat64
+	kindDateTime
+	kindEpochTime
+	kindEnum
+	kindStructure
+	kindList
+	kindMap
+
+	// kindReader is a binary request body member, streamed from an
+	// io.Reader.
+	kindReader
+
+	// kindReadCloser is a binary response payload, the io.ReadCloser of the
+	// response body.
+	kindReadCloser
+
+	// kindBlob is a binary response body read into memory, of a response
+	// that is either JSON or binary.
+	kindBlob
+)
+
+// typeRef is the type of a member, or of the elements of a list or map.
+type typeRef struct {
+	kind  kind
+	shape *shape
+	elem  *typeRef
+}
+
+// member locations of the operation input and output members.
+const (
+	locationURI     = "uri"
+	locationQuery   = "query"
+	locationHeader  = "header"
+	locationBody    = "body"
+	locationPayload = "payload"
+
+	// locationBinary is the member a response body that is not JSON is read
+	// into, of a response that is either JSON or binary.
+	locationBinary = "binary"
+)
+
+type member struct {
+	Name     string
+	Key      string
+	Doc      string
+	Required bool
+	Location string
+	Type     *typeRef
+}
+
+type shape struct {
+	Name       string
+	Doc        string
+	Members    []*member
+	EnumValues []string
+	enum       bool
+}
+
+type errorShape struct {
+	Name  string
+	Doc   string
+	Fault string
+	Codes []string
+}
+
+type operationError struct {
+	StatusCode int
+	Error      *errorShape
+}
+
+type paginator struct {
+	Offset   *member
+	Limit    *member
+	Items    *member
+	Total    *member
+	NextLink *member
+}
+
+type operation struct {
+	Name      string
+	Doc       string
+	Method    string
+	Path      string
+	Input     *shape
+	Output    *shape
+	Errors    []operationError
+	Paginator *paginator
+
+	// The errors matched by error code, before the Errors are matched by
+	// status code.
+	CodeErrors []*errorShape
+
+	// The member the request body is bound to, nil if the body members are
+	// the input's body members.
+	InputPayload *member
+
+	// The member the response body is bound to, nil if the body members are
+	// the output's body members.
+	OutputPayload *member
+
+	// Whether the operation has a JSON request or response body.
+	HasRequestBody  bool
+	HasResponseBody bool
+
+	// The binary request body member streamed as a base64 encoded JSON
+	// string, nil if the request body has none.
+	InputStream *member
+
+	// The member the application/octet-stream request body is streamed from,
+	// nil if the request body is not binary.
+	InputBinary *member
+
+	// Whether the request body is a multipart/form-data body of the input's
+	// body members, streaming its binary members.
+	MultipartRequest bool
+
+	// The output member the binary response body is streamed to, nil if the
+	// response body is not binary.
+	OutputStream *member
+
+	// The output member the response body is read into if the response is
+	// not JSON, nil if the response body is always JSON or always binary. The
+	// bodies of the operation are not logged, as the binary body is not
+	// redacted.
+	OutputBinary *member
+
+	// Whether the response body holds secrets, so it is never logged.
+	SensitiveOutput bool
+}
+
+// Streaming returns whether the operation streams its request or response
+// body.
+func (o *operation) Streaming() bool {
+	return o.InputStream != nil || o.InputBinary != nil || o.MultipartRequest || o.OutputStream != nil
+}
+
+type service struct {
+	ID          string
+	Package     string
+	Title       string
+	Description string
+	Operations  []*operation
+	Structures  []*shape
+	Enums       []*shape
+	Errors      []*errorShape
+}
+
+// builder builds the service model from the spec.
+type builder struct {
+	spec   *spec
+	shapes map[string]*shape
+	errors map[string]*errorShape
+}
+
+func buildService(s *spec) (*service, error) {
+	if len(s.Service.ServiceID) == 0 || len(s.Service.Package) == 0 {
+		return nil, fmt.Errorf("x-cybr-service serviceId and package are required")
+	}
+	if len(s.Servers) == 0 {
+		return nil, fmt.Errorf("a server is required")
+	}
+
+	basePath, err := serverBasePath(s.Servers[0].URL)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &builder{
+		spec:   s,
+		shapes: map[string]*shape{},
+		errors: map[string]*errorShape{},
+	}
+
+	svc := &service{
+		ID:          s.Service.ServiceID,
+		Package:     s.Service.Package,
+		Title:       s.Info.Title,
+		Description: s.Info.Description,
+	}
+
+	for p, methods := range s.Paths {
+		for method, op := range methods {
+			o, err := b.buildOperation(basePath+p, strings.ToUpper(method), op)
+			if err != nil {
+				return nil, fmt.Errorf("%s %s: %w", strings.ToUpper(method), p, err)
+			}
+			svc.Operations = append(svc.Operations, o)
+		}
+	}
+	sort.Slice(svc.Operations, func(i, j int) bool {
+		return svc.Operations[i].Name < svc.Operations[j].Name
+	})
+
+	seen := map[*shape]bool{}
+	for _, o := range svc.Operations {
+		for _, m := range o.Input.Members {
+			collectShapes(m.Type, seen)
+		}
+		for _, m := range o.Output.Members {
+			collectShapes(m.Type, seen)
+		}
+	}
+	for sh := range seen {
+		if sh.enum {
+			svc.Enums = append(svc.Enums, sh)
+		} else {
+			svc.Structures = append(svc.Structures, sh)
+		}
+	}
+	sort.Slice(svc.Enums, func(i, j int) bool { return svc.Enums[i].Name < svc.Enums[j].Name })
+	sort.Slice(svc.Structures, func(i, j int) bool { return svc.Structures[i].Name < svc.Structures[j].Name })
+
+	for _, e := range b.errors {
+		svc.Errors = append(svc.Errors, e)
+	}
+	sort.Slice(svc.Errors, func(i, j int) bool { return svc.Errors[i].Name < svc.Errors[j].Name })
+
+	return svc, nil
+}
+
+// serverBasePath returns the path of the server URL, the operation paths are
+// relative to. The host of the URL is a template, e.g.
+// https://{subdomain}.privilegecloud.{domain}/PasswordVault, that is not
+// parsed, the endpoint resolution of the client resolves the host.
+func serverBasePath(serverURL string) (string, error) {
+	i := strings.Index(serverURL, "://")
+	if i < 0 {
+		return "", fmt.Errorf("invalid server URL %s, scheme is required", serverURL)
+	}
+	rest := serverURL[i+len("://"):]
+
+	j := strings.Index(rest, "/")
+	if j < 0 {
+		return "", nil
+	}
+	return strings.TrimSuffix(rest[j:], "/"), nil
+}
+
+func collectShapes(t *typeRef, seen map[*shape]bool) {
+	switch t.kind {
+	case kindList, kindMap:
+		collectShapes(t.elem, seen)
+	case kindEnum, kindStructure:
+		if seen[t.shape] {
+			return
+		}
+		seen[t.shape] = true
+		for _, m := range t.shape.Members {
+			collectShapes(m.Type, seen)
+		}
+	}
+}
+
+func (b *builder) buildOperation(path, method string, op *specOp) (*operation, error) {
+	if len(op.OperationID) == 0 {
+		return nil, fmt.Errorf("operationId is required")
+	}
+
+	o := &operation{
+		Name:   op.OperationID,
+		Doc:    op.Description,
+		Method: method,
+		Path:   path,
+		Input:  &shape{Name: op.OperationID + "Input"},
+		Output: &shape{Name: op.OperationID + "Output"},
+
+		SensitiveOutput: op.SensitiveOutput,
+	}
+	if len(o.Doc) == 0 {
+		o.Doc = op.Summary
+	}
+
+	for _, p := range op.Parameters {
+		m, err := b.buildParameter(p)
+		if err != nil {
+			return nil, fmt.Errorf("parameter %s: %w", p.Name, err)
+		}
+		o.Input.Members = append(o.Input.Members, m)
+	}
+
+	if body := op.RequestBody; body != nil {
+		if err := b.buildRequestBody(o, body); err != nil {
+			return nil, fmt.Errorf("request body: %w", err)
+		}
+	}
+
+	statusCodes := make([]string, 0, len(op.Responses))
+	for code := range op.Responses {
+		statusCodes = append(statusCodes, code)
+	}
+	sort.Strings(statusCodes)
+
+	for _, code := range statusCodes {
+		resp := op.Responses[code]
+		status, err := strconv.Atoi(code)
+		if err != nil {
+			return nil, fmt.Errorf("invalid response status code %s", code)
+		}
+
+		if status >= 200 && status < 300 {
+			if _, ok := resp.Content["application/json"]; ok && len(resp.Content) > 1 {
+				if o.HasResponseBody || o.OutputStream != nil {
+					return nil, fmt.Errorf("only one response with a body is supported")
+				}
+				if err := b.buildAlternativeResponse(o, resp); err != nil {
+					return nil, fmt.Errorf("response %s: %w", code, err)
+				}
+				continue
+			}
+			if media, ok := resp.Content["application/octet-stream"]; ok {
+				if o.HasResponseBody || o.OutputStream != nil {
+					return nil, fmt.Errorf("only one response with a body is supported")
+				}
+				m, err := buildResponseStream(resp, media)
+				if err != nil {
+					return nil, fmt.Errorf("response %s: %w", code, err)
+				}
+				o.Output.Members = append(o.Output.Members, m)
+				o.OutputStream = m
+				continue
+			}
+
+			media, ok := resp.Content["application/json"]
+			if !ok || media.Schema == nil {
+				continue
+			}
+			if o.HasResponseBody || o.OutputStream != nil {
+				return nil, fmt.Errorf("only one response with a body is supported")
+			}
+			members, payload, err := b.buildBody(media.Schema, resp.Member, resp.Description, false)
+			if err != nil {
+				return nil, fmt.Errorf("response %s: %w", code, err)
+			}
+			for _, m := range members {
+				if m.Type.kind == kindReader {
+					return nil, fmt.Errorf("response %s: binary member %s of a JSON body is not supported", code, m.Name)
+				}
+			}
+			o.Output.Members = append(o.Output.Members, members...)
+			o.OutputPayload = payload
+			o.HasResponseBody = true
+			continue
+		}
+
+		e, err := b.buildError(resp)
+		if err != nil {
+			return nil, fmt.Errorf("response %s: %w", code, err)
+		}
+		o.Errors = append(o.Errors, operationError{StatusCode: status, Error: e})
+	}
+
+	for _, resp := range op.Errors {
+		e, err := b.buildError(resp)
+		if err != nil {
+			return nil, fmt.Errorf("x-cybr-errors: %w", err)
+		}
+		if len(e.Codes) == 0 {
+			return nil, fmt.Errorf("x-cybr-errors: response %s has no x-cybr-error-codes", e.Name)
+		}
+		o.CodeErrors = append(o.CodeErrors, e)
+	}
+
+	for _, sh := range []*shape{o.Input, o.Output} {
+		if err := checkMemberNames(sh); err != nil {
+			return nil, err
+		}
+		sortMembers(sh.Members)
+	}
+
+	if op.Pagination != nil {
+		p, err := buildPaginator(o, op.Pagination)
+		if err != nil {
+			return nil, fmt.Errorf("pagination: %w", err)
+		}
+		o.Paginator = p
+	}
+
+	return o, nil
+}
+
+// buildRequestBody adds the members of the request body to the operation's
+// input. The body is either a JSON document, a binary payload, or a
+// multipart/form-data body of scalar form fields and binary files.
+func (b *builder) buildRequestBody(o *operation, body *specRequestBody) error {
+	if media, ok := body.Content["application/octet-stream"]; ok {
+		if !isBinarySchema(media.Schema) {
+			return fmt.Errorf("application/octet-stream bodies must have a binary string schema")
+		}
+		if len(body.Member) == 0 {
+			return fmt.Errorf("x-cybr-member is required for a binary body")
+		}
+		m := &member{
+			Name:     body.Member,
+			Doc:      body.Description,
+			Required: body.Required,
+			Location: locationPayload,
+			Type:     &typeRef{kind: kindReader},
+		}
+		o.Input.Members = append(o.Input.Members, m)
+		o.InputBinary = m
+		return nil
+	}
+
+	if media, ok := body.Content["multipart/form-data"]; ok {
+		if media.Schema == nil || len(media.Schema.Ref) == 0 {
+			return fmt.Errorf("multipart/form-data bodies must reference an object schema")
+		}
+		target, err := b.lookupSchema(media.Schema.Ref)
+		if err != nil {
+			return err
+		}
+		if target.Type != "object" || target.Properties == nil {
+			return fmt.Errorf("multipart/form-data bodies must reference an object schema")
+		}
+		members, err := b.buildMembers(target, locationBody)
+		if err != nil {
+			return err
+		}
+		for _, m := range members {
+			switch m.Type.kind {
+			case kindStructure, kindList, kindMap:
+				return fmt.Errorf("property %s: only scalar and binary form parts are supported", m.Key)
+			}
+		}
+		o.Input.Members = append(o.Input.Members, members...)
+		o.MultipartRequest = true
+		return nil
+	}
+
+	media, ok := body.Content["application/json"]
+	if !ok || media.Schema == nil {
+		return fmt.Errorf("only application/json, application/octet-stream and multipart/form-data bodies are supported")
+	}
+	members, payload, err := b.buildBody(media.Schema, body.Member, body.Description, body.Required)
+	if err != nil {
+		return err
+	}
+	o.Input.Members = append(o.Input.Members, members...)
+	o.InputPayload = payload
+	o.HasRequestBody = true
+
+	for _, m := range members {
+		if m.Type.kind != kindReader {
+			continue
+		}
+		if m == payload {
+			return fmt.Errorf("binary JSON payloads are not supported, use application/octet-stream")
+		}
+		if o.InputStream != nil {
+			return fmt.Errorf("only one binary member is supported")
+		}
+		o.InputStream = m
+	}
+	return nil
+}
+
+func isBinarySchema(s *specSchema) bool {
+	return s != nil && s.Type == "string" && s.Format == "binary"
+}
+
+// buildResponseStream returns the output member a binary response body is
+// streamed to.
+func buildResponseStream(resp *specResponse, media *specMediaType) (*member, error) {
+	if !isBinarySchema(media.Schema) {
+		return nil, fmt.Errorf("application/octet-stream responses must have a binary string schema")
+	}
+	if len(resp.Member) == 0 {
+		return nil, fmt.Errorf("x-cybr-member is required for a binary response")
+	}
+
+	doc := resp.Description
+	if len(doc) != 0 {
+		doc += "\n\n"
+	}
+	doc += "The body is streamed from the response, and must be closed by the caller."
+	return &member{
+		Name:     resp.Member,
+		Doc:      doc,
+		Location: locationPayload,
+		Type:     &typeRef{kind: kindReadCloser},
+	}, nil
+}
+
+// buildAlternativeResponse adds the members of a response that is either a
+// JSON object or a binary body, depending on the response's Content-Type. The
+// binary body is read into memory, and bound to the response's x-cybr-member.
+func (b *builder) buildAlternativeResponse(o *operation, resp *specResponse) error {
+	binary, ok := resp.Content["application/octet-stream"]
+	if !ok || len(resp.Content) != 2 {
+		return fmt.Errorf("only application/json and application/octet-stream alternative bodies are supported")
+	}
+	if !isBinarySchema(binary.Schema) {
+		return fmt.Errorf("application/octet-stream responses must have a binary string schema")
+	}
+	if len(resp.Member) == 0 {
+		return fmt.Errorf("x-cybr-member is required for a binary response")
+	}
+
+	media := resp.Content["application/json"]
+	if media.Schema == nil {
+		return fmt.Errorf("application/json responses must have a schema")
+	}
+	members, _, err := b.buildBody(media.Schema, "", "", false)
+	if err != nil {
+		return fmt.Errorf("alternative JSON bodies must reference an object schema, %w", err)
+	}
+	for _, m := range members {
+		if m.Type.kind == kindReader {
+			return fmt.Errorf("binary member %s of a JSON body is not supported", m.Name)
+		}
+	}
+
+	doc := resp.Description
+	if len(doc) != 0 {
+		doc += "\n\n"
+	}
+	doc += "Set if the response body is not JSON."
+	m := &member{
+		Name:     resp.Member,
+		Doc:      doc,
+		Location: locationBinary,
+		Type:     &typeRef{kind: kindBlob},
+	}
+	o.Output.Members = append(o.Output.Members, members...)
+	o.Output.Members = append(o.Output.Members, m)
+	o.OutputBinary = m
+	o.HasResponseBody = true
+	return nil
+}
+
+func (b *builder) buildParameter(p *specParameter) (*member, error) {
+	var location string
+	switch p.In {
+	case "path":
+		location = locationURI
+	case "query":
+		location = locationQuery
+	case "header":
+		location = locationHeader
+	default:
+		return nil, fmt.Errorf("unsupported parameter location %s", p.In)
+	}
+	if p.Schema == nil {
+		return nil, fmt.Errorf("schema is required")
+	}
+
+	t, err := b.resolveType(p.Schema)
+	if err != nil {
+		return nil, err
+	}
+	switch t.kind {
+	case kindStructure, kindList, kindMap, kindReader:
+		return nil, fmt.Errorf("only scalar parameters are supported")
+	}
+
+	name := p.Member
+	if len(name) == 0 {
+		name = goName(p.Name)
+	}
+	return &member{
+		Name:     name,
+		Key:      p.Name,
+		Doc:      p.Description,
+		Required: p.Required || location == locationURI,
+		Location: location,
+		Type:     t,
+	}, nil
+}
+
+// buildBody returns the members of a body. The properties of an object
+// schema are flattened into body members, any other body is bound to a
+// single payload member.
+func (b *builder) buildBody(s *specSchema, payloadName, doc string, required bool) ([]*member, *member, error) {
+	if len(s.Ref) != 0 {
+		target, err := b.lookupSchema(s.Ref)
+		if err != nil {
+			return nil, nil, err
+		}
+		if target.Type == "object" && target.Properties != nil && len(payloadName) == 0 {
+			members, err := b.buildMembers(target, locationBody)
+			return members, nil, err
+		}
+	}
+
+	if len(payloadName) == 0 {
+		return nil, nil, fmt.Errorf("x-cybr-member is required for a body that is not an object")
+	}
+	t, err := b.resolveType(s)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(doc) == 0 {
+		doc = s.Description
+	}
+	payload := &member{
+		Name:     payloadName,
+		Doc:      doc,
+		Required: required,
+		Location: locationPayload,
+		Type:     t,
+	}
+	return []*member{payload}, payload, nil
+}
+
+func (b *builder) buildMembers(s *specSchema, location string) ([]*member, error) {
+	required := map[string]bool{}
+	for _, r := range s.Required {
+		required[r] = true
+	}
+
+	var members []*member
+	for key, prop := range s.Properties {
+		t, err := b.resolveType(prop)
+		if err != nil {
+			return nil, fmt.Errorf("property %s: %w", key, err)
+		}
+		name := prop.Member
+		if len(name) == 0 {
+			name = goName(key)
+		}
+		doc := prop.Description
+		if len(doc) == 0 && t.shape != nil {
+			doc = t.shape.Doc
+		}
+		members = append(members, &member{
+			Name:     name,
+			Key:      key,
+			Doc:      doc,
+			Required: required[key],
+			Location: location,
+			Type:     t,
+		})
+	}
+	sortMembers(members)
+	return members, nil
+}
+
+func (b *builder) buildError(resp *specResponse) (*errorShape, error) {
+	const prefix = "#/components/responses/"
+	if !strings.HasPrefix(resp.Ref, prefix) {
+		return nil, fmt.Errorf("error responses must reference a component response")
+	}
+	name := strings.TrimPrefix(resp.Ref, prefix)
+	if e, ok := b.errors[name]; ok {
+		return e, nil
+	}
+
+	target, ok := b.spec.Components.Responses[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown response %s", resp.Ref)
+	}
+	fault := "client"
+	if len(target.Fault) != 0 {
+		fault = target.Fault
+	}
+	if fault != "client" && fault != "server" {
+		return nil, fmt.Errorf("unknown fault %s of response %s", fault, name)
+	}
+
+	e := &errorShape{Name: name, Doc: target.Description, Fault: fault, Codes: target.ErrorCodes}
+	b.errors[name] = e
+	return e, nil
+}
+
+func (b *builder) lookupSchema(ref string) (*specSchema, error) {
+	const prefix = "#/components/schemas/"
+	if !strings.HasPrefix(ref, prefix) {
+		return nil, fmt.Errorf("unsupported reference %s", ref)
+	}
+	s, ok := b.spec.Components.Schemas[strings.TrimPrefix(ref, prefix)]
+	if !ok {
+		return nil, fmt.Errorf("unknown schema %s", ref)
+	}
+	return s, nil
+}
+
+func (b *builder) resolveType(s *specSchema) (*typeRef, error) {
+	if len(s.Ref) != 0 {
+		return b.resolveShape(s.Ref)
+	}
+
+	switch s.Type {
+	case "string":
+		switch s.Format {
+		case "date-time":
+			return &typeRef{kind: kindDateTime}, nil
+		case "binary":
+			return &typeRef{kind: kindReader}, nil
+		}
+		return &typeRef{kind: kindString}, nil
+	case "boolean":
+		return &typeRef{kind: kindBool}, nil
+	case "integer":
+		switch s.Format {
+		case "int64":
+			return &typeRef{kind: kindInt64}, nil
+		case "unix-time":
+			return &typeRef{kind: kindEpochTime}, nil
+		}
+		return &typeRef{kind: kindInt32}, nil
+	case "number":
+		return &typeRef{kind: kindFloat64}, nil
+	case "array":
+		if s.Items == nil {
+			return nil, fmt.Errorf("array items are required")
+		}
+		elem, err := b.resolveType(s.Items)
+		if err != nil {
+			return nil, err
+		}
+		if elem.kind == kindReader {
+			return nil, fmt.Errorf("lists of binary values are not supported")
+		}
+		return &typeRef{kind: kindList, elem: elem}, nil
+	case "object":
+		if s.AdditionalProperties == nil || s.Properties != nil {
+			return nil, fmt.Errorf("objects with properties must be component schemas")
+		}
+		elem, err := b.resolveType(s.AdditionalProperties)
+		if err != nil {
+			return nil, err
+		}
+		if elem.kind == kindReader {
+			return nil, fmt.Errorf("maps of binary values are not supported")
+		}
+		return &typeRef{kind: kindMap, elem: elem}, nil
+	}
+	return nil, fmt.Errorf("unsupported schema type %q", s.Type)
+}
+
+func (b *builder) resolveShape(ref string) (*typeRef, error) {
+	name := ref[strings.LastIndex(ref, "/")+1:]
+	if sh, ok := b.shapes[name]; ok {
+		if sh.enum {
+			return &typeRef{kind: kindEnum, shape: sh}, nil
+		}
+		return &typeRef{kind: kindStructure, shape: sh}, nil
+	}
+
+	s, err := b.lookupSchema(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	sh := &shape{Name: name, Doc: s.Description}
+	b.shapes[name] = sh
+
+	switch {
+	case s.Type == "string" && len(s.Enum) != 0:
+		sh.enum = true
+		sh.EnumValues = s.Enum
+		return &typeRef{kind: kindEnum, shape: sh}, nil
+	case s.Type == "object":
+		if sh.Members, err = b.buildMembers(s, locationBody); err != nil {
+			return nil, fmt.Errorf("schema %s: %w", name, err)
+		}
+		for _, m := range sh.Members {
+			if m.Type.kind == kindReader {
+				return nil, fmt.Errorf("schema %s: binary property %s is only supported in a request body", name, m.Key)
+			}
+		}
+		return &typeRef{kind: kindStructure, shape: sh}, nil
+	}
+	return nil, fmt.Errorf("schema %s must be an object or a string enum", name)
+}
+
+func buildPaginator(o *operation, p *specPagination) (*paginator, error) {
+	pg := &paginator{}
+
+	var err error
+	if pg.Offset, err = findMember(o.Input, p.Offset, kindInt32); err != nil {
+		return nil, err
+	}
+	if pg.Limit, err = findMember(o.Input, p.Limit, kindInt32); err != nil {
+		return nil, err
+	}
+	if pg.Items, err = findMember(o.Output, p.Items, kindList); err != nil {
+		return nil, err
+	}
+	if len(p.Total) != 0 {
+		if pg.Total, err = findMember(o.Output, p.Total, kindInt32); err != nil {
+			return nil, err
+		}
+	}
+	if len(p.NextLink) != 0 {
+		if pg.NextLink, err = findMember(o.Output, p.NextLink, kindString); err != nil {
+			return nil, err
+		}
+	}
+	return pg, nil
+}
+
+func findMember(sh *shape, name string, k kind) (*member, error) {
+	for _, m := range sh.Members {
+		if m.Name == name {
+			if m.Type.kind != k {
+				return nil, fmt.Errorf("member %s of %s has the wrong type", name, sh.Name)
+			}
+			return m, nil
+		}
+	}
+	return nil, fmt.Errorf("%s has no member %s", sh.Name, name)
+}
+
+func checkMemberNames(sh *shape) error {
+	names := map[string]bool{"ResultMetadata": true}
+	for _, m := range sh.Members {
+		if names[m.Name] {
+			return fmt.Errorf("%s has more than one member named %s, use x-cybr-member to rename one", sh.Name, m.Name)
+		}
+		names[m.Name] = true
+	}
+	return nil
+}
+
+// sortMembers sorts the required members first, and the members by name.
+func sortMembers(members []*member) {
+	sort.SliceStable(members, func(i, j int) bool {
+		if members[i].Required != members[j].Required {
+			return members[i].Required
+		}
+		return members[i].Name < members[j].Name
+	})
+}
+
+// goName returns the exported Go name of a JSON key or parameter name, e.g.
+// platformId is PlatformId and error_description is ErrorDescription.
+func goName(s string) string {
+	var sb strings.Builder
+	upper := true
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
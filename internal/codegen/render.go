@@ -0,0 +1,843 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+const (
+	sdkModule     = "github.com/strick-j/cybr-sdk-alpha"
+	generatedNote = "// Code generated by internal/codegen DO NOT EDIT."
+)
+
+// generatedFile is a file of the generated client, relative to the client's
+// package directory.
+type generatedFile struct {
+	Name    string
+	Content []byte
+}
+
+// goImport is an import the generated files may use. The import is added to
+// a file if the file references the package by its name.
+type goImport struct {
+	Name string
+	Path string
+}
+
+func (i goImport) String() string {
+	if i.Path[strings.LastIndex(i.Path, "/")+1:] == i.Name {
+		return fmt.Sprintf("%q", i.Path)
+	}
+	return fmt.Sprintf("%s %q", i.Name, i.Path)
+}
+
+func candidateImports(svc *service) []goImport {
+	return []goImport{
+		{"bytes", "bytes"},
+		{"context", "context"},
+		{"json", "encoding/json"},
+		{"fmt", "fmt"},
+		{"io", "io"},
+		{"mime", "mime"},
+		{"strconv", "strconv"},
+		{"time", "time"},
+		{"cybrmiddleware", sdkModule + "/cybr/middleware"},
+		{"cybrjson", sdkModule + "/cybr/protocol/json"},
+		{"cybrmultipart", sdkModule + "/cybr/protocol/multipart"},
+		{"validation", sdkModule + "/internal/validation"},
+		{"types", sdkModule + "/service/" + svc.Package + "/types"},
+		{"smithy", "github.com/strick-j/smithy-go"},
+		{"smithyjson", "github.com/strick-j/smithy-go/encoding/json"},
+		{"httpbinding", "github.com/strick-j/smithy-go/encoding/httpbinding"},
+		{"smithyio", "github.com/strick-j/smithy-go/io"},
+		{"middleware", "github.com/strick-j/smithy-go/middleware"},
+		{"ptr", "github.com/strick-j/smithy-go/ptr"},
+		{"smithytime", "github.com/strick-j/smithy-go/time"},
+		{"smithyhttp", "github.com/strick-j/smithy-go/transport/http"},
+	}
+}
+
+// render returns the generated files of the service.
+func render(svc *service) ([]generatedFile, error) {
+	r := &renderer{svc: svc}
+
+	var files []generatedFile
+	add := func(name, pkg, tmpl string, data interface{}) error {
+		content, err := r.renderFile(pkg, tmpl, data)
+		if err != nil {
+			return fmt.Errorf("failed to render %s, %w", name, err)
+		}
+		files = append(files, generatedFile{Name: name, Content: content})
+		return nil
+	}
+
+	for _, op := range svc.Operations {
+		if err := add("api_op_"+strings.ToLower(op.Name)+".go", svc.Package, "operation", op); err != nil {
+			return nil, err
+		}
+	}
+	for _, f := range []struct{ name, pkg, tmpl string }{
+		{"serializers.go", svc.Package, "serializers"},
+		{"deserializers.go", svc.Package, "deserializers"},
+		{"validators.go", svc.Package, "validators"},
+		{"types/types.go", "types", "types"},
+		{"types/enums.go", "types", "enums"},
+		{"types/errors.go", "types", "errors"},
+	} {
+		if err := add(f.name, f.pkg, f.tmpl, svc); err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+var commentLine = regexp.MustCompile(`(?m)^\s*//.*$`)
+
+type renderer struct {
+	svc *service
+}
+
+func (r *renderer) renderFile(pkg, tmpl string, data interface{}) ([]byte, error) {
+	t, err := template.New("").Funcs(r.funcs()).Parse(templates)
+	if err != nil {
+		return nil, err
+	}
+
+	var body bytes.Buffer
+	if err := t.ExecuteTemplate(&body, tmpl, data); err != nil {
+		return nil, err
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "%s\n\npackage %s\n\n", generatedNote, pkg)
+
+	// the packages are matched in the code only, e.g. "in bytes." in a doc
+	// comment is not a reference to the bytes package.
+	code := commentLine.ReplaceAll(body.Bytes(), nil)
+
+	var stdImports, imports []goImport
+	for _, i := range candidateImports(r.svc) {
+		if i.Name == pkg || !regexp.MustCompile(`\b`+i.Name+`\.`).Match(code) {
+			continue
+		}
+		if strings.Contains(i.Path, ".") {
+			imports = append(imports, i)
+		} else {
+			stdImports = append(stdImports, i)
+		}
+	}
+	if len(stdImports)+len(imports) != 0 {
+		out.WriteString("import (\n")
+		for _, group := range [][]goImport{stdImports, imports} {
+			sort.Slice(group, func(i, j int) bool { return group[i].Path < group[j].Path })
+			for _, i := range group {
+				out.WriteString(i.String() + "\n")
+			}
+			out.WriteString("\n")
+		}
+		out.WriteString(")\n\n")
+	}
+	out.Write(body.Bytes())
+
+	formatted, err := format.Source(out.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format generated code, %w\n%s", err, out.Bytes())
+	}
+	return formatted, nil
+}
+
+func (r *renderer) funcs() template.FuncMap {
+	return template.FuncMap{
+		"doc":                 doc,
+		"memberDoc":           memberDoc,
+		"memberType":          memberType,
+		"bodyMembers":         bodyMembers,
+		"enumConst":           enumConst,
+		"serializeBindings":   serializeBindings,
+		"serializeMembers":    serializeMembers,
+		"serializeParts":      serializeParts,
+		"deserializeMembers":  deserializeMembers,
+		"validateMembers":     validateMembers,
+		"serializeHelpers":    func() []*typeRef { return r.helpers(true) },
+		"deserializeHelpers":  func() []*typeRef { return r.helpers(false) },
+		"serializeHelper":     serializeHelper,
+		"deserializeHelper":   deserializeHelper,
+		"docName":             docName,
+		"needsValidation":     needsValidation,
+		"validatedStructures": r.validatedStructures,
+		"serializeValue":      serializeValue,
+	}
+}
+
+// helpers returns the structures, lists and maps reachable from the request
+// bodies if serialize is set, otherwise the ones reachable from the
+// response bodies along with the scalar response payloads, sorted by their
+// document serializer names.
+func (r *renderer) helpers(serialize bool) []*typeRef {
+	seen := map[string]*typeRef{}
+	var walk func(t *typeRef)
+	walk = func(t *typeRef) {
+		switch t.kind {
+		case kindStructure, kindList, kindMap:
+		default:
+			return
+		}
+		name := docName(t)
+		if _, ok := seen[name]; ok {
+			return
+		}
+		seen[name] = t
+		if t.kind == kindStructure {
+			for _, m := range t.shape.Members {
+				walk(m.Type)
+			}
+		} else {
+			walk(t.elem)
+		}
+	}
+
+	for _, op := range r.svc.Operations {
+		sh := op.Output
+		if serialize {
+			sh = op.Input
+		}
+		for _, m := range bodyMembers(sh) {
+			walk(m.Type)
+		}
+
+		// scalar response payloads are decoded by a document deserializer
+		// like the structure, list and map payloads.
+		if p := op.OutputPayload; !serialize && p != nil && !isAggregate(p.Type) {
+			seen[docName(p.Type)] = p.Type
+		}
+	}
+
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	refs := make([]*typeRef, 0, len(names))
+	for _, name := range names {
+		refs = append(refs, seen[name])
+	}
+	return refs
+}
+
+// validatedStructures returns the structures that are validated, reachable
+// from the operation inputs.
+func (r *renderer) validatedStructures() []*shape {
+	seen := map[*shape]bool{}
+	var walk func(t *typeRef)
+	walk = func(t *typeRef) {
+		switch t.kind {
+		case kindList, kindMap:
+			walk(t.elem)
+		case kindStructure:
+			if seen[t.shape] || !needsValidation(t.shape) {
+				return
+			}
+			seen[t.shape] = true
+			for _, m := range t.shape.Members {
+				walk(m.Type)
+			}
+		}
+	}
+	for _, op := range r.svc.Operations {
+		for _, m := range op.Input.Members {
+			walk(m.Type)
+		}
+	}
+
+	var shapes []*shape
+	for sh := range seen {
+		shapes = append(shapes, sh)
+	}
+	sort.Slice(shapes, func(i, j int) bool { return shapes[i].Name < shapes[j].Name })
+	return shapes
+}
+
+// doc returns the text as Go comment lines wrapped at 80 columns.
+func doc(text string) string {
+	text = strings.TrimSpace(text)
+	if len(text) == 0 {
+		return ""
+	}
+
+	var out strings.Builder
+	for i, paragraph := range strings.Split(text, "\n\n") {
+		if i > 0 {
+			out.WriteString("//\n")
+		}
+		line := "//"
+		for _, word := range strings.Fields(paragraph) {
+			if len(line)+1+len(word) > 80 && line != "//" {
+				out.WriteString(line + "\n")
+				line = "//"
+			}
+			line += " " + word
+		}
+		out.WriteString(line + "\n")
+	}
+	return out.String()
+}
+
+func memberDoc(m *member) string {
+	text := m.Doc
+	if m.Required {
+		if len(text) != 0 {
+			text += "\n\n"
+		}
+		text += "This member is required."
+	}
+	return doc(text)
+}
+
+// goType returns the Go type of t, qualified with the types package if
+// qualify is set. Scalars are pointers if pointer is set.
+func goType(t *typeRef, qualify, pointer bool) string {
+	q := ""
+	if qualify {
+		q = "types."
+	}
+	p := ""
+	if pointer {
+		p = "*"
+	}
+
+	switch t.kind {
+	case kindString:
+		return p + "string"
+	case kindBool:
+		return p + "bool"
+	case kindInt32:
+		return p + "int32"
+	case kindInt64:
+		return p + "int64"
+	case kindFloat64:
+		return p + "float64"
+	case kindDateTime, kindEpochTime:
+		return p + "time.Time"
+	case kindEnum:
+		return q + t.shape.Name
+	case kindStructure:
+		return p + q + t.shape.Name
+	case kindList:
+		return "[]" + goType(t.elem, qualify, false)
+	case kindMap:
+		return "map[string]" + goType(t.elem, qualify, false)
+	case kindReader:
+		return "io.Reader"
+	case kindReadCloser:
+		return "io.ReadCloser"
+	case kindBlob:
+		return "[]byte"
+	}
+	panic(fmt.Sprintf("unknown kind %d", t.kind))
+}
+
+func memberType(m *member, qualify bool) string {
+	return goType(m.Type, qualify, true)
+}
+
+// docName returns the name of the document serializer and deserializer of a
+// structure, list or map, e.g. AccountList for a list of Account.
+func docName(t *typeRef) string {
+	switch t.kind {
+	case kindString:
+		return "String"
+	case kindBool:
+		return "Boolean"
+	case kindInt32:
+		return "Integer"
+	case kindInt64:
+		return "Long"
+	case kindFloat64:
+		return "Double"
+	case kindDateTime:
+		return "DateTime"
+	case kindEpochTime:
+		return "EpochTime"
+	case kindEnum, kindStructure:
+		return t.shape.Name
+	case kindList:
+		return docName(t.elem) + "List"
+	case kindMap:
+		return docName(t.elem) + "Map"
+	}
+	panic(fmt.Sprintf("unknown kind %d", t.kind))
+}
+
+func enumConst(sh *shape, value string) string {
+	return sh.Name + goName(value)
+}
+
+func bindings(sh *shape) []*member {
+	var members []*member
+	for _, m := range sh.Members {
+		switch m.Location {
+		case locationURI, locationQuery, locationHeader:
+			members = append(members, m)
+		}
+	}
+	return members
+}
+
+func bodyMembers(sh *shape) []*member {
+	var members []*member
+	for _, m := range sh.Members {
+		switch m.Location {
+		case locationBody, locationPayload:
+			members = append(members, m)
+		}
+	}
+	return members
+}
+
+// memberAccess returns the condition of the member of v being set, and the
+// expression of its value. The value of a structure is a pointer.
+func memberAccess(m *member, v string) (isSet, value string) {
+	field := v + "." + m.Name
+	switch m.Type.kind {
+	case kindEnum:
+		return "len(" + field + ") > 0", field
+	case kindStructure, kindList, kindMap, kindReader, kindReadCloser, kindBlob:
+		return field + " != nil", field
+	}
+	return field + " != nil", "*" + field
+}
+
+// serializeValue returns the statements encoding the value v into the JSON
+// value named target.
+func serializeValue(t *typeRef, v, target string) string {
+	switch t.kind {
+	case kindString:
+		return target + ".String(" + v + ")\n"
+	case kindEnum:
+		return target + ".String(string(" + v + "))\n"
+	case kindBool:
+		return target + ".Boolean(" + v + ")\n"
+	case kindInt32:
+		return target + ".Integer(" + v + ")\n"
+	case kindInt64:
+		return target + ".Long(" + v + ")\n"
+	case kindFloat64:
+		return target + ".Double(" + v + ")\n"
+	case kindDateTime:
+		return target + ".String(smithytime.FormatDateTime(" + v + "))\n"
+	case kindEpochTime:
+		return target + ".Long(" + strings.TrimPrefix(v, "*") + ".Unix())\n"
+	}
+	return "if err := cybrRestjson_serializeDocument" + docName(t) + "(" + v + ", " + target + "); err != nil {\n" +
+		"return err\n" +
+		"}\n"
+}
+
+// serializeMembers returns the statements encoding the body members of the
+// shape named v into the JSON object named object. Binary members are
+// streamed after the object is encoded, and are skipped.
+func serializeMembers(members []*member, v, object string) string {
+	var out strings.Builder
+	for _, m := range members {
+		if m.Type.kind == kindReader {
+			continue
+		}
+		isSet, value := memberAccess(m, v)
+		out.WriteString("if " + isSet + " {\n")
+		out.WriteString("ok := " + object + ".Key(\"" + m.Key + "\")\n")
+		out.WriteString(serializeValue(m.Type, value, "ok"))
+		out.WriteString("}\n\n")
+	}
+	return out.String()
+}
+
+// serializeParts returns the statements appending the body members of the
+// shape named v to the multipart/form-data parts named parts.
+func serializeParts(sh *shape, v string) string {
+	var out strings.Builder
+	for _, m := range bodyMembers(sh) {
+		isSet, value := memberAccess(m, v)
+		out.WriteString("if " + isSet + " {\n")
+		out.WriteString("parts = append(parts, cybrmultipart.Part{Name: \"" + m.Key + "\", ")
+		if m.Type.kind == kindReader {
+			out.WriteString("Content: " + value)
+		} else {
+			out.WriteString("Value: " + partValue(m.Type, value))
+		}
+		out.WriteString("})\n}\n\n")
+	}
+	return out.String()
+}
+
+// partValue returns the expression of the form field value of a scalar.
+func partValue(t *typeRef, value string) string {
+	switch t.kind {
+	case kindEnum:
+		return "string(" + value + ")"
+	case kindBool:
+		return "strconv.FormatBool(" + value + ")"
+	case kindInt32:
+		return "strconv.FormatInt(int64(" + value + "), 10)"
+	case kindInt64:
+		return "strconv.FormatInt(" + value + ", 10)"
+	case kindFloat64:
+		return "strconv.FormatFloat(" + value + ", 'f', -1, 64)"
+	case kindDateTime:
+		return "smithytime.FormatDateTime(" + value + ")"
+	case kindEpochTime:
+		return "strconv.FormatInt(" + strings.TrimPrefix(value, "*") + ".Unix(), 10)"
+	}
+	return value
+}
+
+// bindingValue returns the call encoding the value of the member of v with a
+// httpbinding value.
+func bindingValue(m *member, v string) string {
+	_, value := memberAccess(m, v)
+	switch m.Type.kind {
+	case kindEnum:
+		return ".String(string(" + value + "))"
+	case kindBool:
+		return ".Boolean(" + value + ")"
+	case kindInt32:
+		return ".Integer(" + value + ")"
+	case kindInt64:
+		return ".Long(" + value + ")"
+	case kindFloat64:
+		return ".Double(" + value + ")"
+	case kindDateTime:
+		return ".String(smithytime.FormatDateTime(" + value + "))"
+	case kindEpochTime:
+		return ".Long(" + strings.TrimPrefix(value, "*") + ".Unix())"
+	}
+	return ".String(" + value + ")"
+}
+
+// serializeBindings returns the statements encoding the members of the input
+// named v bound to the URI, query and headers with the encoder.
+func serializeBindings(sh *shape, v string) string {
+	var out strings.Builder
+	for _, m := range bindings(sh) {
+		isSet, _ := memberAccess(m, v)
+		switch m.Location {
+		case locationURI:
+			empty := v + "." + m.Name + " == nil"
+			switch m.Type.kind {
+			case kindString:
+				empty += " || len(*" + v + "." + m.Name + ") == 0"
+			case kindEnum:
+				empty = "len(" + v + "." + m.Name + ") == 0"
+			}
+			out.WriteString("if " + empty + " {\n")
+			out.WriteString("return &smithy.SerializationError{Err: fmt.Errorf(\"input member " + m.Name + " must not be empty\")}\n")
+			out.WriteString("}\n")
+			out.WriteString("if " + isSet + " {\n")
+			out.WriteString("if err := encoder.SetURI(\"" + m.Key + "\")" + bindingValue(m, v) + "; err != nil {\n")
+			out.WriteString("return err\n}\n}\n\n")
+		case locationQuery:
+			out.WriteString("if " + isSet + " {\n")
+			out.WriteString("encoder.SetQuery(\"" + m.Key + "\")" + bindingValue(m, v) + "\n")
+			out.WriteString("}\n\n")
+		case locationHeader:
+			out.WriteString("if " + isSet + " {\n")
+			out.WriteString("encoder.SetHeader(\"" + m.Key + "\")" + bindingValue(m, v) + "\n")
+			out.WriteString("}\n\n")
+		}
+	}
+	return out.String()
+}
+
+// serializeHelper returns the document serializer of a structure, list or
+// map.
+func serializeHelper(t *typeRef) string {
+	var out strings.Builder
+	name := docName(t)
+	out.WriteString("func cybrRestjson_serializeDocument" + name + "(v ")
+	switch t.kind {
+	case kindStructure:
+		out.WriteString("*types." + t.shape.Name + ", value smithyjson.Value) error {\n")
+		out.WriteString("object := value.Object()\ndefer object.Close()\n\n")
+		out.WriteString(serializeMembers(t.shape.Members, "v", "object"))
+	case kindList:
+		out.WriteString(goType(t, true, false) + ", value smithyjson.Value) error {\n")
+		out.WriteString("array := value.Array()\ndefer array.Close()\n\n")
+		out.WriteString("for i := range v {\nav := array.Value()\n")
+		elem := "v[i]"
+		if t.elem.kind == kindStructure {
+			elem = "&v[i]"
+		}
+		out.WriteString(serializeValue(t.elem, elem, "av"))
+		out.WriteString("}\n")
+	case kindMap:
+		out.WriteString(goType(t, true, false) + ", value smithyjson.Value) error {\n")
+		out.WriteString("object := value.Object()\ndefer object.Close()\n\n")
+		out.WriteString("for key := range v {\nom := object.Key(key)\n")
+		elem := "v[key]"
+		if t.elem.kind == kindStructure {
+			out.WriteString("mv := v[key]\n")
+			elem = "&mv"
+		}
+		out.WriteString(serializeValue(t.elem, elem, "om"))
+		out.WriteString("}\n")
+	}
+	out.WriteString("return nil\n}\n")
+	return out.String()
+}
+
+// deserializeScalar returns the statements decoding the JSON scalar named
+// value, passing the decoded Go value to assign. The decoded value is a
+// pointer if pointer is set.
+func deserializeScalar(t *typeRef, pointer bool, assign func(string) string) string {
+	wrap := func(ptrFn, v string) string {
+		if pointer && len(ptrFn) != 0 {
+			return assign("ptr." + ptrFn + "(" + v + ")")
+		}
+		return assign(v)
+	}
+
+	var out strings.Builder
+	switch t.kind {
+	case kindString, kindEnum, kindDateTime:
+		out.WriteString("jtv, ok := value.(string)\n")
+		out.WriteString("if !ok {\n")
+		out.WriteString("return fmt.Errorf(\"expected " + docName(t) + " to be of type string, got %T instead\", value)\n")
+		out.WriteString("}\n")
+		switch t.kind {
+		case kindString:
+			out.WriteString(wrap("String", "jtv"))
+		case kindEnum:
+			out.WriteString(wrap("", "types."+t.shape.Name+"(jtv)"))
+		case kindDateTime:
+			out.WriteString("t, err := smithytime.ParseDateTime(jtv)\nif err != nil {\nreturn err\n}\n")
+			out.WriteString(wrap("Time", "t"))
+		}
+	case kindBool:
+		out.WriteString("jtv, ok := value.(bool)\n")
+		out.WriteString("if !ok {\n")
+		out.WriteString("return fmt.Errorf(\"expected Boolean to be of type *bool, got %T instead\", value)\n")
+		out.WriteString("}\n")
+		out.WriteString(wrap("Bool", "jtv"))
+	case kindInt32, kindInt64, kindFloat64, kindEpochTime:
+		out.WriteString("jtv, ok := value.(json.Number)\n")
+		out.WriteString("if !ok {\n")
+		out.WriteString("return fmt.Errorf(\"expected " + docName(t) + " to be json.Number, got %T instead\", value)\n")
+		out.WriteString("}\n")
+		switch t.kind {
+		case kindInt32:
+			out.WriteString("i64, err := jtv.Int64()\nif err != nil {\nreturn err\n}\n")
+			out.WriteString(wrap("Int32", "int32(i64)"))
+		case kindInt64:
+			out.WriteString("i64, err := jtv.Int64()\nif err != nil {\nreturn err\n}\n")
+			out.WriteString(wrap("Int64", "i64"))
+		case kindFloat64:
+			out.WriteString("f64, err := jtv.Float64()\nif err != nil {\nreturn err\n}\n")
+			out.WriteString(wrap("Float64", "f64"))
+		case kindEpochTime:
+			out.WriteString("f64, err := jtv.Float64()\nif err != nil {\nreturn err\n}\n")
+			out.WriteString(wrap("Time", "smithytime.ParseEpochSeconds(f64)"))
+		}
+	}
+	return out.String()
+}
+
+// deserializeMembers returns the switch cases decoding the JSON values of the
+// members into the fields of sv.
+func deserializeMembers(members []*member) string {
+	var out strings.Builder
+	for _, m := range members {
+		out.WriteString("case \"" + m.Key + "\":\n")
+		switch m.Type.kind {
+		case kindStructure, kindList, kindMap:
+			out.WriteString("if err := cybrRestjson_deserializeDocument" + docName(m.Type) + "(&sv." + m.Name + ", value); err != nil {\n")
+			out.WriteString("return err\n}\n\n")
+		default:
+			out.WriteString("if value != nil {\n")
+			out.WriteString(deserializeScalar(m.Type, true, func(v string) string {
+				return "sv." + m.Name + " = " + v + "\n"
+			}))
+			out.WriteString("}\n\n")
+		}
+	}
+	return out.String()
+}
+
+// deserializeElem returns the statements decoding the JSON value named value
+// into the variable named dest.
+func deserializeElem(t *typeRef, dest string) string {
+	switch t.kind {
+	case kindStructure:
+		return "destAddr := &" + dest + "\n" +
+			"if err := cybrRestjson_deserializeDocument" + docName(t) + "(&destAddr, value); err != nil {\n" +
+			"return err\n}\n" +
+			dest + " = *destAddr\n"
+	case kindList, kindMap:
+		return "if err := cybrRestjson_deserializeDocument" + docName(t) + "(&" + dest + ", value); err != nil {\n" +
+			"return err\n}\n"
+	}
+	return "if value != nil {\n" +
+		deserializeScalar(t, false, func(v string) string { return dest + " = " + v + "\n" }) +
+		"}\n"
+}
+
+// isAggregate returns whether the type is a structure, list or map.
+func isAggregate(t *typeRef) bool {
+	switch t.kind {
+	case kindStructure, kindList, kindMap:
+		return true
+	}
+	return false
+}
+
+// deserializeHelper returns the document deserializer of a structure, list,
+// map, or of a scalar response payload.
+func deserializeHelper(t *typeRef) string {
+	var out strings.Builder
+	name := docName(t)
+	typ := goType(t, true, false)
+
+	if !isAggregate(t) {
+		out.WriteString("func cybrRestjson_deserializeDocument" + name + "(v *" + goType(t, true, true) + ", value interface{}) error {\n")
+		out.WriteString("if v == nil {\nreturn fmt.Errorf(\"unexpected nil of type %T\", v)\n}\n")
+		out.WriteString("if value == nil {\nreturn nil\n}\n\n")
+		out.WriteString(deserializeScalar(t, true, func(v string) string { return "*v = " + v + "\n" }))
+		out.WriteString("return nil\n}\n")
+		return out.String()
+	}
+
+	jsonType := "map[string]interface{}"
+	if t.kind == kindList {
+		jsonType = "[]interface{}"
+	}
+
+	if t.kind == kindStructure {
+		out.WriteString("func cybrRestjson_deserializeDocument" + name + "(v **" + typ + ", value interface{}) error {\n")
+	} else {
+		out.WriteString("func cybrRestjson_deserializeDocument" + name + "(v *" + typ + ", value interface{}) error {\n")
+	}
+	out.WriteString("if v == nil {\nreturn fmt.Errorf(\"unexpected nil of type %T\", v)\n}\n")
+	out.WriteString("if value == nil {\nreturn nil\n}\n\n")
+	out.WriteString("shape, ok := value.(" + jsonType + ")\n")
+	out.WriteString("if !ok {\nreturn fmt.Errorf(\"unexpected JSON type %v\", value)\n}\n\n")
+
+	switch t.kind {
+	case kindStructure:
+		out.WriteString(deserializeStructureBody(typ, t.shape.Members))
+	case kindList:
+		out.WriteString("var cv " + typ + "\n")
+		out.WriteString("if *v == nil {\ncv = " + typ + "{}\n} else {\ncv = *v\n}\n\n")
+		out.WriteString("for _, value := range shape {\n")
+		out.WriteString("var col " + goType(t.elem, true, false) + "\n")
+		out.WriteString(deserializeElem(t.elem, "col"))
+		out.WriteString("cv = append(cv, col)\n}\n")
+		out.WriteString("*v = cv\nreturn nil\n")
+	case kindMap:
+		out.WriteString("var mv " + typ + "\n")
+		out.WriteString("if *v == nil {\nmv = " + typ + "{}\n} else {\nmv = *v\n}\n\n")
+		out.WriteString("for key, value := range shape {\n")
+		out.WriteString("var parsedVal " + goType(t.elem, true, false) + "\n")
+		out.WriteString(deserializeElem(t.elem, "parsedVal"))
+		out.WriteString("mv[key] = parsedVal\n}\n")
+		out.WriteString("*v = mv\nreturn nil\n")
+	}
+	out.WriteString("}\n")
+	return out.String()
+}
+
+// deserializeStructureBody returns the statements decoding the JSON object
+// named shape into the structure v of the type typ.
+func deserializeStructureBody(typ string, members []*member) string {
+	var out strings.Builder
+	out.WriteString("var sv *" + typ + "\n")
+	out.WriteString("if *v == nil {\nsv = &" + typ + "{}\n} else {\nsv = *v\n}\n\n")
+	out.WriteString("for key, value := range shape {\nswitch key {\n")
+	out.WriteString(deserializeMembers(members))
+	out.WriteString("default:\n_, _ = key, value\n\n}\n}\n")
+	out.WriteString("*v = sv\nreturn nil\n")
+	return out.String()
+}
+
+// needsValidation returns whether a structure has required or enum members,
+// or members of structures that need validation.
+func needsValidation(sh *shape) bool {
+	return shapeNeedsValidation(sh, map[*shape]bool{})
+}
+
+func shapeNeedsValidation(sh *shape, visiting map[*shape]bool) bool {
+	if visiting[sh] {
+		return false
+	}
+	visiting[sh] = true
+	defer delete(visiting, sh)
+
+	for _, m := range sh.Members {
+		if m.Required || typeNeedsValidation(m.Type, visiting) {
+			return true
+		}
+	}
+	return false
+}
+
+func typeNeedsValidation(t *typeRef, visiting map[*shape]bool) bool {
+	switch t.kind {
+	case kindEnum:
+		return true
+	case kindStructure:
+		return shapeNeedsValidation(t.shape, visiting)
+	case kindList, kindMap:
+		return typeNeedsValidation(t.elem, visiting)
+	}
+	return false
+}
+
+// validateMembers returns the statements validating the members of v,
+// adding the invalid parameters to invalidParams.
+func validateMembers(sh *shape) string {
+	var out strings.Builder
+	for _, m := range sh.Members {
+		field := "v." + m.Name
+		if m.Required {
+			if m.Type.kind == kindEnum {
+				out.WriteString("if len(" + field + ") == 0 {\n")
+			} else {
+				out.WriteString("if " + field + " == nil {\n")
+			}
+			out.WriteString("invalidParams.Add(smithy.NewErrParamRequired(\"" + m.Name + "\"))\n}\n")
+		}
+
+		switch t := m.Type; t.kind {
+		case kindEnum:
+			out.WriteString("if err := validation.EnumValue(\"" + m.Name + "\", " + field + ", types." + t.shape.Name + "(\"\").Values()); err != nil {\n")
+			out.WriteString("invalidParams.Add(err)\n}\n")
+		case kindStructure:
+			if !needsValidation(t.shape) {
+				continue
+			}
+			out.WriteString("if " + field + " != nil {\n")
+			out.WriteString("if err := validate" + t.shape.Name + "(" + field + "); err != nil {\n")
+			out.WriteString("invalidParams.AddNested(\"" + m.Name + "\", err.(smithy.InvalidParamsError))\n}\n}\n")
+		case kindList, kindMap:
+			index, key := "i", "[%d]"
+			if t.kind == kindMap {
+				index, key = "k", "[%s]"
+			}
+			switch e := t.elem; {
+			case e.kind == kindEnum:
+				out.WriteString("for " + index + ", e := range " + field + " {\n")
+				out.WriteString("if err := validation.EnumValue(fmt.Sprintf(\"" + m.Name + key + "\", " + index + "), e, types." + e.shape.Name + "(\"\").Values()); err != nil {\n")
+				out.WriteString("invalidParams.Add(err)\n}\n}\n")
+			case e.kind == kindStructure && needsValidation(e.shape):
+				out.WriteString("for " + index + " := range " + field + " {\n")
+				out.WriteString("e := " + field + "[" + index + "]\n")
+				out.WriteString("if err := validate" + e.shape.Name + "(&e); err != nil {\n")
+				out.WriteString("invalidParams.AddNested(fmt.Sprintf(\"" + m.Name + key + "\", " + index + "), err.(smithy.InvalidParamsError))\n}\n}\n")
+			}
+		}
+	}
+	return out.String()
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// The subset of the OpenAPI 3 document the generator supports, along with the
+// x-cybr vendor extensions used to describe what OpenAPI cannot, e.g. the
+// Go names of members, and how the list operations are paginated.
+
+type spec struct {
+	Info       specInfo                      `json:"info"`
+	Servers    []specServer                  `json:"servers"`
+	Service    specService                   `json:"x-cybr-service"`
+	Paths      map[string]map[string]*specOp `json:"paths"`
+	Components specComponents                `json:"components"`
+}
+
+type specInfo struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Version     string `json:"version"`
+}
+
+type specServer struct {
+	URL string `json:"url"`
+}
+
+type specService struct {
+	// The ID of the service, the ServiceID of the generated client.
+	ServiceID string `json:"serviceId"`
+
+	// The name of the Go package of the generated client.
+	Package string `json:"package"`
+}
+
+type specComponents struct {
+	Schemas   map[string]*specSchema   `json:"schemas"`
+	Responses map[string]*specResponse `json:"responses"`
+}
+
+type specOp struct {
+	OperationID string                   `json:"operationId"`
+	Summary     string                   `json:"summary"`
+	Description string                   `json:"description"`
+	Parameters  []*specParameter         `json:"parameters"`
+	RequestBody *specRequestBody         `json:"requestBody"`
+	Responses   map[string]*specResponse `json:"responses"`
+	Pagination  *specPagination          `json:"x-cybr-pagination"`
+
+	// Whether the response body holds secrets, e.g. a password, which are
+	// not redacted from the logged body, so the response body is never
+	// logged.
+	SensitiveOutput bool `json:"x-cybr-sensitive-output"`
+
+	// The error responses matched by the error code of the response rather
+	// than its status code, referencing component responses with
+	// x-cybr-error-codes.
+	Errors []*specResponse `json:"x-cybr-errors"`
+}
+
+type specParameter struct {
+	Name        string      `json:"name"`
+	In          string      `json:"in"`
+	Description string      `json:"description"`
+	Required    bool        `json:"required"`
+	Schema      *specSchema `json:"schema"`
+	Member      string      `json:"x-cybr-member"`
+}
+
+type specRequestBody struct {
+	Description string                    `json:"description"`
+	Required    bool                      `json:"required"`
+	Content     map[string]*specMediaType `json:"content"`
+	Member      string                    `json:"x-cybr-member"`
+}
+
+type specResponse struct {
+	Ref         string                    `json:"$ref"`
+	Description string                    `json:"description"`
+	Content     map[string]*specMediaType `json:"content"`
+	Member      string                    `json:"x-cybr-member"`
+	Fault       string                    `json:"x-cybr-fault"`
+
+	// The CyberArk error codes of an error response matched by error code,
+	// e.g. an error requiring a reason, returned with the same status code as
+	// other errors.
+	ErrorCodes []string `json:"x-cybr-error-codes"`
+}
+
+type specMediaType struct {
+	Schema *specSchema `json:"schema"`
+}
+
+type specSchema struct {
+	Ref                  string                 `json:"$ref"`
+	Type                 string                 `json:"type"`
+	Format               string                 `json:"format"`
+	Description          string                 `json:"description"`
+	Enum                 []string               `json:"enum"`
+	Required             []string               `json:"required"`
+	Properties           map[string]*specSchema `json:"properties"`
+	Items                *specSchema            `json:"items"`
+	AdditionalProperties *specSchema            `json:"additionalProperties"`
+	Member               string                 `json:"x-cybr-member"`
+}
+
+// specPagination describes an operation paginated with an offset and limit,
+// naming the members of the operation's input and output.
+type specPagination struct {
+	// The input member of the number of items skipped.
+	Offset string `json:"offset"`
+
+	// The input member of the maximum number of items of a page.
+	Limit string `json:"limit"`
+
+	// The output member of the items of a page.
+	Items string `json:"items"`
+
+	// The optional output member of the total number of items.
+	Total string `json:"total"`
+
+	// The optional output member of the link to the next page, not set on the
+	// last page.
+	NextLink string `json:"nextLink"`
+}
+
+func loadSpec(filename string) (*spec, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read spec, %w", err)
+	}
+
+	var s spec
+	if err := json.Unmarshal(b, &s); err != nil {
+		return nil, fmt.Errorf("failed to parse spec %s, %w", filename, err)
+	}
+	return &s, nil
+}
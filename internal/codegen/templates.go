@@ -0,0 +1,676 @@
+package main
+
+// templates are the templates of the generated files, rendered without their
+// package clause and imports.
+const templates = `
+{{- define "members" }}
+{{- range . }}
+{{ memberDoc . }}	{{ .Name }} {{ memberType . true }}
+{{ end }}
+{{- end }}
+
+{{- define "operation" }}
+{{ doc .Doc }}func (c *Client) {{ .Name }}(ctx context.Context, params *{{ .Name }}Input, optFns ...func(*Options)) (*{{ .Name }}Output, error) {
+	if params == nil {
+		params = &{{ .Name }}Input{}
+	}
+
+	result, metadata, err := c.invokeOperation(ctx, "{{ .Name }}", params, optFns, c.addOperation{{ .Name }}Middlewares)
+	if err != nil {
+		return nil, err
+	}
+
+	out := result.(*{{ .Name }}Output)
+	out.ResultMetadata = metadata
+	return out, nil
+}
+
+type {{ .Name }}Input struct {
+{{- template "members" .Input.Members }}
+}
+
+type {{ .Name }}Output struct {
+{{- template "members" .Output.Members }}
+	// Metadata pertaining to the operation's result.
+	ResultMetadata middleware.Metadata
+}
+
+func (c *Client) addOperation{{ .Name }}Middlewares(stack *middleware.Stack, options Options) (err error) {
+	if err := stack.Serialize.Add(&setOperationInputMiddleware{}, middleware.After); err != nil {
+		return err
+	}
+	err = stack.Serialize.Add(&cybrRestjson_serializeOp{{ .Name }}{}, middleware.After)
+	if err != nil {
+		return err
+	}
+	err = stack.Deserialize.Add(&cybrRestjson_deserializeOp{{ .Name }}{}, middleware.After)
+	if err != nil {
+		return err
+	}
+	if err := addProtocolFinalizerMiddlewares(stack, options, "{{ .Name }}"); err != nil {
+		return fmt.Errorf("add protocol finalizers: %v", err)
+	}
+
+	if err = addSetLoggerMiddleware(stack, options); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddClientRequestIDMiddleware(stack); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddRequestAttemptMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addUserAgentMiddleware(stack, options); err != nil {
+		return err
+	}
+	if err = smithyhttp.AddComputeContentLengthMiddleware(stack); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddRawResponseToMetadata(stack); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddRecordResponseTiming(stack); err != nil {
+		return err
+	}
+	if err = smithyhttp.AddErrorCloseResponseBodyMiddleware(stack); err != nil {
+		return err
+	}
+{{- if not .OutputStream }}
+	if err = smithyhttp.AddCloseResponseBodyMiddleware(stack); err != nil {
+		return err
+	}
+{{- end }}
+{{- if needsValidation .Input }}
+	if err = addOp{{ .Name }}ValidationMiddleware(stack); err != nil {
+		return err
+	}
+{{- end }}
+	if err = stack.Initialize.Add(newServiceMetadataMiddleware_op{{ .Name }}(options.Subdomain, options.Domain), middleware.Before); err != nil {
+		return err
+	}
+	if err = addRequestIDRetrieverMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addResponseErrorMiddleware(stack); err != nil {
+		return err
+	}
+{{- if or .Streaming .OutputBinary }}
+	if err = addStreamingRequestResponseLogging(stack, options); err != nil {
+		return err
+	}
+{{- else if .SensitiveOutput }}
+	if err = addSensitiveOutputRequestResponseLogging(stack, options); err != nil {
+		return err
+	}
+{{- else }}
+	if err = addRequestResponseLogging(stack, options); err != nil {
+		return err
+	}
+{{- end }}
+	if err = addRateLimitMiddleware(stack, options); err != nil {
+		return err
+	}
+	if err = addTracingMiddleware(stack, options); err != nil {
+		return err
+	}
+
+	return nil
+}
+{{ with .Paginator }}
+// {{ $.Name }}APIClient is a client that implements the {{ $.Name }} operation.
+type {{ $.Name }}APIClient interface {
+	{{ $.Name }}(context.Context, *{{ $.Name }}Input, ...func(*Options)) (*{{ $.Name }}Output, error)
+}
+
+var _ {{ $.Name }}APIClient = (*Client)(nil)
+
+// {{ $.Name }}PaginatorOptions is the paginator options for {{ $.Name }}
+type {{ $.Name }}PaginatorOptions struct {
+	// The maximum number of items to return per page. The service default is
+	// used if not set.
+	Limit int32
+}
+
+// {{ $.Name }}Paginator is a paginator for {{ $.Name }}
+type {{ $.Name }}Paginator struct {
+	options {{ $.Name }}PaginatorOptions
+	client  {{ $.Name }}APIClient
+	params  *{{ $.Name }}Input
+	offset  int32
+	done    bool
+}
+
+// New{{ $.Name }}Paginator returns a new {{ $.Name }}Paginator
+func New{{ $.Name }}Paginator(client {{ $.Name }}APIClient, params *{{ $.Name }}Input, optFns ...func(*{{ $.Name }}PaginatorOptions)) *{{ $.Name }}Paginator {
+	if params == nil {
+		params = &{{ $.Name }}Input{}
+	}
+
+	options := {{ $.Name }}PaginatorOptions{}
+	if params.{{ .Limit.Name }} != nil {
+		options.Limit = *params.{{ .Limit.Name }}
+	}
+
+	for _, fn := range optFns {
+		fn(&options)
+	}
+
+	var offset int32
+	if params.{{ .Offset.Name }} != nil {
+		offset = *params.{{ .Offset.Name }}
+	}
+
+	return &{{ $.Name }}Paginator{
+		options: options,
+		client:  client,
+		params:  params,
+		offset:  offset,
+	}
+}
+
+// HasMorePages returns a boolean indicating whether more pages are available
+func (p *{{ $.Name }}Paginator) HasMorePages() bool {
+	return !p.done
+}
+
+// NextPage retrieves the next {{ $.Name }} page.
+func (p *{{ $.Name }}Paginator) NextPage(ctx context.Context, optFns ...func(*Options)) (*{{ $.Name }}Output, error) {
+	if !p.HasMorePages() {
+		return nil, fmt.Errorf("no more pages available")
+	}
+
+	params := *p.params
+	offset := p.offset
+	params.{{ .Offset.Name }} = &offset
+
+	var limit *int32
+	if p.options.Limit > 0 {
+		limit = &p.options.Limit
+	}
+	params.{{ .Limit.Name }} = limit
+
+	result, err := p.client.{{ $.Name }}(ctx, &params, optFns...)
+	if err != nil {
+		return nil, err
+	}
+
+	count := int32(len(result.{{ .Items.Name }}))
+	p.offset += count
+	p.done = count == 0
+{{- with .NextLink }}
+	if result.{{ .Name }} == nil || len(*result.{{ .Name }}) == 0 {
+		p.done = true
+	}
+{{- end }}
+{{- with .Total }}
+	if result.{{ .Name }} != nil && p.offset >= *result.{{ .Name }} {
+		p.done = true
+	}
+{{- end }}
+
+	return result, nil
+}
+{{ end }}
+func newServiceMetadataMiddleware_op{{ .Name }}(subdomain string, domain string) *cybrmiddleware.RegisterServiceMetadata {
+	return &cybrmiddleware.RegisterServiceMetadata{
+		Subdomain:     subdomain,
+		Domain:        domain,
+		ServiceID:     ServiceID,
+		OperationName: "{{ .Name }}",
+	}
+}
+{{- end }}
+
+{{- define "serializers" }}
+{{- range .Operations }}
+type cybrRestjson_serializeOp{{ .Name }} struct {
+}
+
+func (*cybrRestjson_serializeOp{{ .Name }}) ID() string {
+	return "OperationSerializer"
+}
+
+func (m *cybrRestjson_serializeOp{{ .Name }}) HandleSerialize(ctx context.Context, in middleware.SerializeInput, next middleware.SerializeHandler) (
+	out middleware.SerializeOutput, metadata middleware.Metadata, err error,
+) {
+	request, ok := in.Request.(*smithyhttp.Request)
+	if !ok {
+		return out, metadata, &smithy.SerializationError{Err: fmt.Errorf("unknown transport type %T", in.Request)}
+	}
+
+	input, ok := in.Parameters.(*{{ .Name }}Input)
+	if !ok {
+		return out, metadata, &smithy.SerializationError{Err: fmt.Errorf("unknown input parameters type %T", in.Parameters)}
+	}
+
+	opPath, opQuery := httpbinding.SplitURI("{{ .Path }}")
+	request.URL.Path = smithyhttp.JoinPath(request.URL.Path, opPath)
+	request.URL.RawQuery = smithyhttp.JoinRawQuery(request.URL.RawQuery, opQuery)
+	request.Method = "{{ .Method }}"
+	var restEncoder *httpbinding.Encoder
+	if request.URL.RawPath == "" {
+		restEncoder, err = httpbinding.NewEncoder(request.URL.Path, request.URL.RawQuery, request.Header)
+	} else {
+		request.URL.RawPath = smithyhttp.JoinPath(request.URL.RawPath, opPath)
+		restEncoder, err = httpbinding.NewEncoderWithRawPath(request.URL.Path, request.URL.RawPath, request.URL.RawQuery, request.Header)
+	}
+	if err != nil {
+		return out, metadata, &smithy.SerializationError{Err: err}
+	}
+
+	if err := cybrRestjson_serializeOpHttpBindings{{ .Name }}Input(input, restEncoder); err != nil {
+		return out, metadata, &smithy.SerializationError{Err: err}
+	}
+{{- if .OutputBinary }}
+	restEncoder.SetHeader("Accept").String("application/json, application/octet-stream")
+{{- else if .HasResponseBody }}
+	restEncoder.SetHeader("Accept").String("application/json")
+{{- end }}
+{{- if .HasRequestBody }}
+{{- if .InputPayload }}
+
+	if input.{{ .InputPayload.Name }} != nil {
+{{- end }}
+	restEncoder.SetHeader("Content-Type").String("application/json")
+
+	jsonEncoder := smithyjson.NewEncoder()
+	if err := cybrRestjson_serializeOpDocument{{ .Name }}Input(input, jsonEncoder.Value); err != nil {
+		return out, metadata, &smithy.SerializationError{Err: err}
+	}
+
+
+{{- with .InputStream }}
+
+	var body io.Reader = bytes.NewReader(jsonEncoder.Bytes())
+	if input.{{ .Name }} != nil {
+		body, err = cybrjson.NewBase64MemberReader(jsonEncoder.Bytes(), "{{ .Key }}", input.{{ .Name }})
+		if err != nil {
+			return out, metadata, &smithy.SerializationError{Err: err}
+		}
+	}
+	if request, err = request.SetStream(body); err != nil {
+		return out, metadata, &smithy.SerializationError{Err: err}
+	}
+{{- else }}
+
+	if request, err = request.SetStream(bytes.NewReader(jsonEncoder.Bytes())); err != nil {
+		return out, metadata, &smithy.SerializationError{Err: err}
+	}
+{{- end }}
+{{- if .InputPayload }}
+	}
+{{- end }}
+{{- end }}
+{{- with .InputBinary }}
+
+	if input.{{ .Name }} != nil {
+		restEncoder.SetHeader("Content-Type").String("application/octet-stream")
+		if request, err = request.SetStream(input.{{ .Name }}); err != nil {
+			return out, metadata, &smithy.SerializationError{Err: err}
+		}
+	}
+{{- end }}
+{{- if .MultipartRequest }}
+
+	body, contentType, err := cybrmultipart.NewFormReader(cybrRestjson_serializeOpParts{{ .Name }}Input(input))
+	if err != nil {
+		return out, metadata, &smithy.SerializationError{Err: err}
+	}
+	restEncoder.SetHeader("Content-Type").String(contentType)
+	if request, err = request.SetStream(body); err != nil {
+		return out, metadata, &smithy.SerializationError{Err: err}
+	}
+{{- end }}
+
+	if request.Request, err = restEncoder.Encode(request.Request); err != nil {
+		return out, metadata, &smithy.SerializationError{Err: err}
+	}
+	in.Request = request
+
+	return next.HandleSerialize(ctx, in)
+}
+
+func cybrRestjson_serializeOpHttpBindings{{ .Name }}Input(v *{{ .Name }}Input, encoder *httpbinding.Encoder) error {
+	if v == nil {
+		return fmt.Errorf("unsupported serialization of nil %T", v)
+	}
+
+{{ serializeBindings .Input "v" }}
+	return nil
+}
+{{- if .HasRequestBody }}
+
+func cybrRestjson_serializeOpDocument{{ .Name }}Input(v *{{ .Name }}Input, value smithyjson.Value) error {
+{{- if .InputPayload }}
+	{{ serializeValue .InputPayload.Type (print "v." .InputPayload.Name) "value" }}
+{{- else }}
+	object := value.Object()
+	defer object.Close()
+
+{{ serializeMembers (bodyMembers .Input) "v" "object" }}
+{{- end }}
+	return nil
+}
+{{- end }}
+{{- if .MultipartRequest }}
+
+func cybrRestjson_serializeOpParts{{ .Name }}Input(v *{{ .Name }}Input) []cybrmultipart.Part {
+	var parts []cybrmultipart.Part
+{{ serializeParts .Input "v" }}
+	return parts
+}
+{{- end }}
+{{ end }}
+{{- range serializeHelpers }}
+{{ serializeHelper . }}
+{{- end }}
+{{- end }}
+
+{{- define "deserializers" }}
+{{- range .Operations }}
+type cybrRestjson_deserializeOp{{ .Name }} struct {
+}
+
+func (*cybrRestjson_deserializeOp{{ .Name }}) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOp{{ .Name }}) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpError{{ .Name }}(response, &metadata)
+	}
+	output := &{{ .Name }}Output{}
+	out.Result = output
+{{ if .HasResponseBody }}
+{{- if .OutputBinary }}
+	if mediaType, _, _ := mime.ParseMediaType(response.Header.Get("Content-Type")); mediaType != "application/json" {
+		output.{{ .OutputBinary.Name }}, err = io.ReadAll(response.Body)
+		if err != nil {
+			return out, metadata, &smithy.DeserializationError{
+				Err: fmt.Errorf("failed to read response body, %w", err),
+			}
+		}
+		return out, metadata, nil
+	}
+{{ end }}
+	var buff [1024]byte
+	ringBuffer := smithyio.NewRingBuffer(buff[:])
+
+	body := io.TeeReader(response.Body, ringBuffer)
+
+	decoder := json.NewDecoder(body)
+	decoder.UseNumber()
+	var shape interface{}
+	if err := decoder.Decode(&shape); err != nil && err != io.EOF {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		err = &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+		return out, metadata, err
+	}
+{{ if .OutputPayload }}
+	err = cybrRestjson_deserializeDocument{{ docName .OutputPayload.Type }}(&output.{{ .OutputPayload.Name }}, shape)
+{{- else }}
+	err = cybrRestjson_deserializeOpDocument{{ .Name }}Output(&output, shape)
+{{- end }}
+	if err != nil {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		return out, metadata, &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+	}
+{{ else if .OutputStream }}
+	output.{{ .OutputStream.Name }} = response.Body
+{{ else }}
+	if _, err = io.Copy(io.Discard, response.Body); err != nil {
+		return out, metadata, &smithy.DeserializationError{
+			Err: fmt.Errorf("failed to discard response body, %w", err),
+		}
+	}
+{{ end }}
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpError{{ .Name }}(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+{{- if .CodeErrors }}
+
+	switch errorCode {
+{{- range .CodeErrors }}
+	case {{ range $i, $code := .Codes }}{{ if $i }}, {{ end }}{{ printf "%q" $code }}{{ end }}:
+		return cybrRestjson_deserializeError{{ .Name }}(errorCode, errorMessage)
+{{- end }}
+	}
+{{- end }}
+
+	switch response.StatusCode {
+{{- range .Errors }}
+	case {{ .StatusCode }}:
+		return cybrRestjson_deserializeError{{ .Error.Name }}(errorCode, errorMessage)
+{{- end }}
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+{{- if and .HasResponseBody (not .OutputPayload) }}
+
+func cybrRestjson_deserializeOpDocument{{ .Name }}Output(v **{{ .Name }}Output, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *{{ .Name }}Output
+	if *v == nil {
+		sv = &{{ .Name }}Output{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+{{ deserializeMembers (bodyMembers .Output) }}
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+{{- end }}
+{{ end }}
+{{- range .Errors }}
+func cybrRestjson_deserializeError{{ .Name }}(errorCode, errorMessage string) error {
+	output := &types.{{ .Name }}{}
+	if len(errorCode) != 0 {
+		output.ErrorCodeOverride = ptr.String(errorCode)
+	}
+	if len(errorMessage) != 0 {
+		output.Message = ptr.String(errorMessage)
+	}
+	return output
+}
+{{ end }}
+{{- range deserializeHelpers }}
+{{ deserializeHelper . }}
+{{- end }}
+{{- end }}
+
+{{- define "validators" }}
+{{- range .Operations }}
+{{- if needsValidation .Input }}
+type validateOp{{ .Name }} struct {
+}
+
+func (*validateOp{{ .Name }}) ID() string {
+	return "OperationInputValidation"
+}
+
+func (m *validateOp{{ .Name }}) HandleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (
+	out middleware.InitializeOutput, metadata middleware.Metadata, err error,
+) {
+	input, ok := in.Parameters.(*{{ .Name }}Input)
+	if !ok {
+		return out, metadata, fmt.Errorf("unknown input parameters type %T", in.Parameters)
+	}
+	if err := validateOp{{ .Name }}Input(input); err != nil {
+		return out, metadata, err
+	}
+	return next.HandleInitialize(ctx, in)
+}
+{{ end }}
+{{- end }}
+{{- range .Operations }}
+{{- if needsValidation .Input }}
+func addOp{{ .Name }}ValidationMiddleware(stack *middleware.Stack) error {
+	return stack.Initialize.Add(&validateOp{{ .Name }}{}, middleware.After)
+}
+{{ end }}
+{{- end }}
+{{- range validatedStructures }}
+func validate{{ .Name }}(v *types.{{ .Name }}) error {
+	if v == nil {
+		return nil
+	}
+	invalidParams := smithy.InvalidParamsError{Context: "{{ .Name }}"}
+{{ validateMembers . }}
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	} else {
+		return nil
+	}
+}
+{{ end }}
+{{- range .Operations }}
+{{- if needsValidation .Input }}
+func validateOp{{ .Name }}Input(v *{{ .Name }}Input) error {
+	if v == nil {
+		return nil
+	}
+	invalidParams := smithy.InvalidParamsError{Context: "{{ .Name }}Input"}
+{{ validateMembers .Input }}
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	} else {
+		return nil
+	}
+}
+{{ end }}
+{{- end }}
+{{- end }}
+
+{{- define "types" }}
+{{- range .Structures }}
+{{ doc .Doc }}type {{ .Name }} struct {
+{{- range .Members }}
+{{ memberDoc . }}	{{ .Name }} {{ memberType . false }}
+{{ end }}
+}
+{{ end }}
+{{- end }}
+
+{{- define "enums" }}
+{{- range $enum := .Enums }}
+{{ doc .Doc }}type {{ .Name }} string
+
+// Enum values for {{ .Name }}
+const (
+{{- range .EnumValues }}
+	{{ enumConst $enum . }} {{ $enum.Name }} = "{{ . }}"
+{{- end }}
+)
+
+// Values returns all known values for {{ .Name }}. Note that this can be expanded
+// in the future, and so it is only as up to date as the client. The ordering of
+// this slice is not guaranteed to be stable across updates.
+func ({{ .Name }}) Values() []{{ .Name }} {
+	return []{{ .Name }}{
+{{- range .EnumValues }}
+		"{{ . }}",
+{{- end }}
+	}
+}
+{{ end }}
+{{- end }}
+
+{{- define "errors" }}
+{{- range .Errors }}
+{{ doc .Doc }}type {{ .Name }} struct {
+	// The error message of the response.
+	Message *string
+
+	// The CyberArk error code of the response, e.g. PASWS013E. ErrorCode
+	// returns the name of the error if the response has no error code.
+	ErrorCodeOverride *string
+}
+
+func (e *{{ .Name }}) Error() string {
+	return fmt.Sprintf("%s: %s", e.ErrorCode(), e.ErrorMessage())
+}
+func (e *{{ .Name }}) ErrorMessage() string {
+	if e.Message == nil {
+		return ""
+	}
+	return *e.Message
+}
+func (e *{{ .Name }}) ErrorCode() string {
+	if e == nil || e.ErrorCodeOverride == nil {
+		return "{{ .Name }}"
+	}
+	return *e.ErrorCodeOverride
+}
+func (e *{{ .Name }}) ErrorFault() smithy.ErrorFault {
+{{- if eq .Fault "server" }}
+	return smithy.FaultServer
+{{- else }}
+	return smithy.FaultClient
+{{- end }}
+}
+{{ end }}
+{{- end }}
+`
@@ -0,0 +1,30 @@
+// Package configsources provides helpers for service clients to resolve
+// service-specific configuration from the sources a cybr.Config was loaded
+// from.
+package configsources
+
+import (
+	"context"
+)
+
+// ServiceBaseEndpointProvider is needed to search for all providers
+// that provide a configured service endpoint
+type ServiceBaseEndpointProvider interface {
+	GetServiceBaseEndpoint(ctx context.Context, sdkID string) (string, bool, error)
+}
+
+// ResolveServiceBaseEndpoint returns the base endpoint configured for the
+// service in the first of the config sources that provides one, e.g. the
+// endpoint_url of the service's block in a [services ...] section of the
+// shared config.
+func ResolveServiceBaseEndpoint(ctx context.Context, sdkID string, configs []interface{}) (value string, found bool, err error) {
+	for _, cs := range configs {
+		if p, ok := cs.(ServiceBaseEndpointProvider); ok {
+			value, found, err = p.GetServiceBaseEndpoint(ctx, sdkID)
+			if err != nil || found {
+				break
+			}
+		}
+	}
+	return
+}
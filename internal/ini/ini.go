@@ -47,6 +47,10 @@ func Parse(r io.Reader, path string) (Sections, error) {
 	}
 
 	lines := strings.Split(string(contents), "\n")
+	if n := len(lines); n != 0 && lines[n-1] == "" {
+		// drop the empty line following the file's final newline
+		lines = lines[:n-1]
+	}
 	tokens, err := tokenize(lines)
 	if err != nil {
 		return Sections{}, fmt.Errorf("tokenize: %v", err)
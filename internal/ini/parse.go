@@ -18,11 +18,15 @@ type parser struct {
 	csection, ckey string   // current state
 	path           string   // source file path
 	sections       Sections // parse result
+	seq            int      // order of the sections parsed
+	comments       []string // comment lines pending the next token
 }
 
 func (p *parser) parse(tokens []lineToken) {
 	for _, otok := range tokens {
 		switch tok := otok.(type) {
+		case *lineTokenComment:
+			p.comments = append(p.comments, tok.Value)
 		case *lineTokenProfile:
 			p.handleProfile(tok)
 		case *lineTokenProperty:
@@ -33,6 +37,21 @@ func (p *parser) parse(tokens []lineToken) {
 			p.handleContinuation(tok)
 		}
 	}
+
+	// comments after the last property of the file are retained by the last
+	// section.
+	if p.csection != "" && len(p.comments) != 0 {
+		layout := p.sections.container[p.csection].layout
+		layout.trailing = append(layout.trailing, p.comments...)
+		p.comments = nil
+	}
+}
+
+// takeComments returns the comment lines pending the current token.
+func (p *parser) takeComments() []string {
+	c := p.comments
+	p.comments = nil
+	return c
 }
 
 func (p *parser) handleProfile(tok *lineTokenProfile) {
@@ -43,12 +62,24 @@ func (p *parser) handleProfile(tok *lineTokenProfile) {
 	p.ckey = ""
 	p.csection = name
 	if _, ok := p.sections.container[name]; !ok {
-		p.sections.container[name] = NewSection(name)
+		p.seq++
+		section := NewSection(name)
+		section.layout.seq = p.seq
+		section.layout.comments = p.takeComments()
+		section.layout.comment = tok.Comment
+		p.sections.container[name] = section
+		return
 	}
+
+	// A duplicate section header, properties are merged into the existing
+	// section, the header's comments are retained as trailing comments.
+	layout := p.sections.container[name].layout
+	layout.trailing = append(layout.trailing, p.takeComments()...)
 }
 
 func (p *parser) handleProperty(tok *lineTokenProperty) {
 	if p.csection == "" {
+		p.comments = nil
 		return // LEGACY: don't error on "global" properties
 	}
 
@@ -68,10 +99,16 @@ func (p *parser) handleProperty(tok *lineTokenProperty) {
 		str: tok.Value,
 	}
 	p.sections.container[p.csection].SourceFile[tok.Key] = p.path
+
+	prop := p.sections.container[p.csection].layout.property(tok.Key)
+	prop.rawKey = tok.RawKey
+	prop.comments = append(prop.comments, p.takeComments()...)
+	prop.comment = tok.Comment
 }
 
 func (p *parser) handleSubProperty(tok *lineTokenSubProperty) {
 	if p.csection == "" {
+		p.comments = nil
 		return // LEGACY: don't error on "global" properties
 	}
 
@@ -80,19 +117,24 @@ func (p *parser) handleSubProperty(tok *lineTokenSubProperty) {
 		// the beginning of a section or because the last property's
 		// value isn't empty. Either way we're lenient here and
 		// "promote" this to a normal property.
+		uncommented := trimPropertyComment(tok.Value)
 		p.handleProperty(&lineTokenProperty{
-			Key:   tok.Key,
-			Value: strings.TrimSpace(trimPropertyComment(tok.Value)),
+			Key:     tok.Key,
+			RawKey:  tok.RawKey,
+			Value:   strings.TrimSpace(uncommented),
+			Comment: strings.TrimSpace(tok.Value[len(uncommented):]),
 		})
 		return
 	}
 
-	if p.sections.container[p.csection].values[p.ckey].mp == nil {
-		p.sections.container[p.csection].values[p.ckey] = Value{
+	value := p.sections.container[p.csection].values[p.ckey]
+	if value.mp == nil {
+		value = Value{
 			mp: map[string]string{},
 		}
 	}
-	p.sections.container[p.csection].values[p.ckey].mp[tok.Key] = tok.Value
+	value = value.withSubProperty(tok.Key, tok.RawKey, tok.Value, p.takeComments())
+	p.sections.container[p.csection].values[p.ckey] = value
 }
 
 func (p *parser) handleContinuation(tok *lineTokenContinuation) {
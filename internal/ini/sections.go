@@ -31,12 +31,33 @@ func (t Sections) HasSection(p string) bool {
 	return ok
 }
 
-// SetSection sets a section value for provided section name.
+// SetSection sets a section value for provided section name. Sections not
+// previously set are ordered after the existing sections when written.
 func (t Sections) SetSection(p string, v Section) Sections {
+	if v.layout == nil {
+		v.layout = newSectionLayout()
+	}
+	if v.layout.seq == 0 {
+		if old, ok := t.container[p]; ok && old.layout != nil && old.layout.seq != 0 {
+			v.layout.seq = old.layout.seq
+		} else {
+			v.layout.seq = t.maxSeq() + 1
+		}
+	}
 	t.container[p] = v
 	return t
 }
 
+func (t Sections) maxSeq() int {
+	var max int
+	for _, v := range t.container {
+		if v.layout != nil && v.layout.seq > max {
+			max = v.layout.seq
+		}
+	}
+	return max
+}
+
 // DeleteSection deletes a section entry/value for provided section name./
 func (t Sections) DeleteSection(p string) {
 	delete(t.container, p)
@@ -78,6 +99,52 @@ type Section struct {
 	// was retrieved. They key is the property, value is the
 	// source file the property was retrieved from.
 	SourceFile map[string]string
+
+	// layout retains the order and comments of the section and its
+	// properties, shared by copies of the section.
+	layout *sectionLayout
+}
+
+// sectionLayout is the position and comments of a section in the file it was
+// parsed from.
+type sectionLayout struct {
+	seq        int
+	comments   []string
+	comment    string
+	trailing   []string
+	properties map[string]*propertyLayout
+}
+
+// propertyLayout is the position, key and comments of a property in the file
+// it was parsed from.
+type propertyLayout struct {
+	order    int
+	rawKey   string
+	comments []string
+	comment  string
+}
+
+func newSectionLayout() *sectionLayout {
+	return &sectionLayout{
+		properties: map[string]*propertyLayout{},
+	}
+}
+
+// property returns the layout of the property, adding it after the section's
+// existing properties if not already present.
+func (l *sectionLayout) property(k string) *propertyLayout {
+	if p, ok := l.properties[k]; ok {
+		return p
+	}
+	var max int
+	for _, p := range l.properties {
+		if p.order > max {
+			max = p.order
+		}
+	}
+	p := &propertyLayout{order: max + 1, rawKey: k}
+	l.properties[k] = p
+	return p
 }
 
 // NewSection returns an initialize section for the name
@@ -86,6 +153,7 @@ func NewSection(name string) Section {
 		Name:       name,
 		values:     values{},
 		SourceFile: map[string]string{},
+		layout:     newSectionLayout(),
 	}
 }
 
@@ -111,9 +179,27 @@ func (t Section) UpdateSourceFile(property string, filepath string) {
 // UpdateValue updates value for a provided key with provided value
 func (t Section) UpdateValue(k string, v Value) error {
 	t.values[k] = v
+	if t.layout != nil {
+		t.layout.property(k)
+	}
 	return nil
 }
 
+// DeleteValue deletes the value, and its comments, for the provided key.
+func (t Section) DeleteValue(k string) {
+	delete(t.values, k)
+	delete(t.SourceFile, k)
+	if t.layout != nil {
+		delete(t.layout.properties, k)
+	}
+}
+
+// GetValue returns the value at k, and if the value exists.
+func (t Section) GetValue(k string) (Value, bool) {
+	v, ok := t.values[k]
+	return v, ok
+}
+
 // Has will return whether or not an entry exists in a given section
 func (t Section) Has(k string) bool {
 	_, ok := t.values[k]
@@ -155,3 +241,12 @@ func (t Section) String(k string) string {
 	}
 	return t.values[k].StringValue()
 }
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
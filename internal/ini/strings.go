@@ -1,6 +1,7 @@
 package ini
 
 import (
+	"fmt"
 	"strings"
 )
 
@@ -76,6 +77,27 @@ func legacyStrconv(s string) string {
 	return s
 }
 
+// checkValue returns an error if the property value would not be parsed back
+// as is once written. Inline comments are cut from property values even
+// within quotes, and the parser has no escape for a backslash, so values
+// containing either cannot be written.
+func checkValue(s string) error {
+	if strings.ContainsAny(s, "\r\n") {
+		return fmt.Errorf("value cannot contain a line break")
+	}
+	for _, seq := range []string{" #", " ;", "\t#", "\t;"} {
+		if strings.Contains(s, seq) {
+			return fmt.Errorf("value cannot contain %q, it would be parsed as a comment", seq)
+		}
+	}
+	for _, seq := range []string{`\"`, `\'`, `\n`} {
+		if strings.Contains(s, seq) {
+			return fmt.Errorf("value cannot contain %q, it would be parsed as an escape sequence", seq)
+		}
+	}
+	return nil
+}
+
 func isSingleQuoted(s string) bool {
 	return hasAffixes(s, "'", "'")
 }
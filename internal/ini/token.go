@@ -5,15 +5,18 @@ type lineToken interface {
 }
 
 type lineTokenProfile struct {
-	Type string
-	Name string
+	Type    string
+	Name    string
+	Comment string
 }
 
 func (*lineTokenProfile) isLineToken() {}
 
 type lineTokenProperty struct {
-	Key   string
-	Value string
+	Key     string
+	RawKey  string
+	Value   string
+	Comment string
 }
 
 func (*lineTokenProperty) isLineToken() {}
@@ -25,8 +28,17 @@ type lineTokenContinuation struct {
 func (*lineTokenContinuation) isLineToken() {}
 
 type lineTokenSubProperty struct {
-	Key   string
-	Value string
+	Key    string
+	RawKey string
+	Value  string
 }
 
 func (*lineTokenSubProperty) isLineToken() {}
+
+// lineTokenComment is a comment or blank line. Comments are retained so that
+// they can be preserved when the sections are written back out.
+type lineTokenComment struct {
+	Value string
+}
+
+func (*lineTokenComment) isLineToken() {}
@@ -8,6 +8,9 @@ func tokenize(lines []string) ([]lineToken, error) {
 	tokens := make([]lineToken, 0, len(lines))
 	for _, line := range lines {
 		if len(strings.TrimSpace(line)) == 0 || isLineComment(line) {
+			tokens = append(tokens, &lineTokenComment{
+				Value: strings.TrimRight(line, " \t\r"),
+			})
 			continue
 		}
 
@@ -30,7 +33,8 @@ func isLineComment(line string) bool {
 }
 
 func asProfile(line string) *lineTokenProfile { // " [ type name ] ; comment"
-	trimmed := strings.TrimSpace(trimProfileComment(line)) // "[ type name ]"
+	uncommented := trimProfileComment(line)
+	trimmed := strings.TrimSpace(uncommented) // "[ type name ]"
 	if !isBracketed(trimmed) {
 		return nil
 	}
@@ -38,8 +42,9 @@ func asProfile(line string) *lineTokenProfile { // " [ type name ] ; comment"
 	trimmed = strings.TrimSpace(trimmed)  // "type name" / "name"
 	typ, name := splitProfile(trimmed)
 	return &lineTokenProfile{
-		Type: typ,
-		Name: name,
+		Type:    typ,
+		Name:    name,
+		Comment: strings.TrimSpace(line[len(uncommented):]),
 	}
 }
 
@@ -48,16 +53,18 @@ func asProperty(line string) *lineTokenProperty {
 		return nil
 	}
 
-	trimmed := trimPropertyComment(line)
-	trimmed = strings.TrimRight(trimmed, " \t")
+	uncommented := trimPropertyComment(line)
+	trimmed := strings.TrimRight(uncommented, " \t")
 	k, v, ok := splitProperty(trimmed)
 	if !ok {
 		return nil
 	}
 
 	return &lineTokenProperty{
-		Key:   strings.ToLower(k), // LEGACY: normalize key case
-		Value: legacyStrconv(v),   // LEGACY: see func docs
+		Key:     strings.ToLower(k), // LEGACY: normalize key case
+		RawKey:  k,
+		Value:   legacyStrconv(v), // LEGACY: see func docs
+		Comment: strings.TrimSpace(line[len(uncommented):]),
 	}
 }
 
@@ -74,8 +81,9 @@ func asSubProperty(line string) *lineTokenSubProperty {
 	}
 
 	return &lineTokenSubProperty{ // same LEGACY constraints as in normal property
-		Key:   strings.ToLower(k),
-		Value: legacyStrconv(v),
+		Key:    strings.ToLower(k),
+		RawKey: k,
+		Value:  legacyStrconv(v),
 	}
 }
 
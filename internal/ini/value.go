@@ -34,13 +34,59 @@ type Value struct {
 
 	str string
 	mp  map[string]string
+
+	// order, raw keys and preceding comments of the sub properties, retained
+	// for writing the value back out.
+	subKeys     []string
+	subRawKeys  map[string]string
+	subComments map[string][]string
 }
 
-// NewStringValue returns a Value type generated using a string input.
+// NewStringValue returns a Value type generated using a string input. An
+// error is returned if the string would not be parsed back as is once
+// written, e.g. it contains an inline comment or escape sequence.
 func NewStringValue(str string) (Value, error) {
+	if err := checkValue(str); err != nil {
+		return Value{}, err
+	}
 	return Value{str: str}, nil
 }
 
+// NewMapValue returns a Value type of sub properties generated using a map
+// input. The sub properties are written in key order. An error is returned if
+// a sub property's value would not be parsed back as is once written.
+func NewMapValue(mp map[string]string) (Value, error) {
+	v := Value{mp: map[string]string{}}
+	for _, k := range sortedKeys(mp) {
+		if err := checkValue(mp[k]); err != nil {
+			return Value{}, fmt.Errorf("invalid %s value, %w", k, err)
+		}
+		v = v.withSubProperty(strings.ToLower(k), k, mp[k], nil)
+	}
+	return v, nil
+}
+
+// withSubProperty returns a copy of the value with the sub property set.
+func (v Value) withSubProperty(key, rawKey, val string, comments []string) Value {
+	if _, ok := v.mp[key]; !ok {
+		v.subKeys = append(v.subKeys[:len(v.subKeys):len(v.subKeys)], key)
+	}
+	v.mp[key] = val
+
+	if v.subRawKeys == nil {
+		v.subRawKeys = map[string]string{}
+	}
+	v.subRawKeys[key] = rawKey
+
+	if len(comments) != 0 {
+		if v.subComments == nil {
+			v.subComments = map[string][]string{}
+		}
+		v.subComments[key] = append(v.subComments[key], comments...)
+	}
+	return v
+}
+
 func (v Value) String() string {
 	switch v.Type {
 	case StringType:
@@ -54,6 +100,14 @@ func (v Value) String() string {
 
 // MapValue returns a map value for sub properties
 func (v Value) MapValue() map[string]string {
+	if v.mp != nil {
+		mp := make(map[string]string, len(v.mp))
+		for k, val := range v.mp {
+			mp[k] = val
+		}
+		return mp
+	}
+
 	newlineParts := strings.Split(string(v.str), "\n")
 	mp := make(map[string]string)
 	for _, part := range newlineParts {
@@ -0,0 +1,169 @@
+package ini
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/strick-j/cybr-sdk-alpha/internal/atomicfile"
+)
+
+// Write writes the sections to the writer in the INI format. Sections and
+// properties parsed from a file are written in the order they were parsed in,
+// along with their comments and sub properties. Sections and properties added
+// after parsing are written after the existing ones.
+//
+// Values are written as parsed, quoted values are unquoted by the parser and
+// will only be quoted when written if required.
+func Write(w io.Writer, sections Sections) error {
+	bw := bufio.NewWriter(w)
+
+	names := sections.orderedList()
+	for i, name := range names {
+		section := sections.container[name]
+		layout := section.layout
+		if layout == nil {
+			layout = newSectionLayout()
+		}
+
+		if len(layout.comments) == 0 && i != 0 {
+			// separate sections added after parsing from the previous section
+			fmt.Fprintln(bw)
+		}
+		writeLines(bw, layout.comments)
+		fmt.Fprintf(bw, "[%s]%s\n", name, inlineComment(layout.comment))
+
+		for _, key := range section.orderedKeys() {
+			prop, ok := layout.properties[key]
+			if !ok {
+				prop = &propertyLayout{rawKey: key}
+			}
+			writeLines(bw, prop.comments)
+			writeProperty(bw, prop, section.values[key])
+		}
+
+		writeLines(bw, layout.trailing)
+	}
+
+	return bw.Flush()
+}
+
+// WriteFile writes the sections to the file at path. The file is written
+// atomically, the sections are written to a temporary file in the same
+// directory which is then renamed to path, so readers never observe a
+// partially written file. The file is created with the permissions perm, and
+// its parent directories with owner only permissions if they do not exist.
+func WriteFile(path string, sections Sections, perm os.FileMode) error {
+	var buf bytes.Buffer
+	if err := Write(&buf, sections); err != nil {
+		return err
+	}
+	return atomicfile.WriteFile(path, buf.Bytes(), perm)
+}
+
+func writeProperty(w io.Writer, prop *propertyLayout, v Value) {
+	if v.mp != nil {
+		fmt.Fprintf(w, "%s =%s\n", prop.rawKey, inlineComment(prop.comment))
+
+		keys := append([]string{}, v.subKeys...)
+		for _, k := range sortedKeys(v.mp) {
+			if !containsString(keys, k) {
+				keys = append(keys, k)
+			}
+		}
+		for _, k := range keys {
+			val, ok := v.mp[k]
+			if !ok {
+				continue
+			}
+			rawKey := k
+			if rk, ok := v.subRawKeys[k]; ok {
+				rawKey = rk
+			}
+			writeLines(w, v.subComments[k])
+			fmt.Fprintf(w, "  %s = %s\n", rawKey, quoteValue(val))
+		}
+		return
+	}
+
+	lines := strings.Split(v.str, "\n")
+	fmt.Fprintf(w, "%s = %s%s\n", prop.rawKey, quoteValue(lines[0]), inlineComment(prop.comment))
+	for _, line := range lines[1:] {
+		fmt.Fprintf(w, "  %s\n", line)
+	}
+}
+
+// quoteValue quotes the value if it would not otherwise be parsed as is, i.e.
+// it has surrounding whitespace or quotes, or begins like a comment.
+func quoteValue(s string) string {
+	if s == "" {
+		return s
+	}
+	if s != strings.TrimSpace(s) || s != unquote(s) || strings.IndexAny(s, "#;") == 0 {
+		s = strings.ReplaceAll(s, `"`, `\"`)
+		return `"` + s + `"`
+	}
+	return s
+}
+
+func inlineComment(c string) string {
+	if c == "" {
+		return ""
+	}
+	return " " + c
+}
+
+func writeLines(w io.Writer, lines []string) {
+	for _, line := range lines {
+		fmt.Fprintln(w, line)
+	}
+}
+
+func containsString(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
+
+// orderedList returns the names of the sections in the order they are
+// written.
+func (t Sections) orderedList() []string {
+	names := t.List()
+	sort.SliceStable(names, func(i, j int) bool {
+		return t.container[names[i]].seq() < t.container[names[j]].seq()
+	})
+	return names
+}
+
+func (t Section) seq() int {
+	if t.layout == nil {
+		return 0
+	}
+	return t.layout.seq
+}
+
+// orderedKeys returns the keys of the section's properties in the order they
+// are written.
+func (t Section) orderedKeys() []string {
+	keys := t.List()
+	order := func(k string) int {
+		if t.layout == nil {
+			return 0
+		}
+		if p, ok := t.layout.properties[k]; ok {
+			return p.order
+		}
+		return int(^uint(0) >> 1)
+	}
+	sort.SliceStable(keys, func(i, j int) bool {
+		return order(keys[i]) < order(keys[j])
+	})
+	return keys
+}
@@ -0,0 +1,145 @@
+package ini
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestWriteRoundTrip(t *testing.T) {
+	cases := map[string]string{
+		"comments and order": `# header comment
+
+[profile zeta] ; zeta comment
+subdomain = zeta
+; property comment
+cybr_username = user # inline
+
+[default]
+Subdomain = default
+`,
+		"sub properties": `[services local]
+privilege_cloud =
+  endpoint_url = http://localhost:8443
+  # sub property comment
+  other = value
+generic =
+  endpoint_url = http://localhost:8080
+`,
+		"continuation and quoting": `[profile a]
+multiline = first
+  second
+quoted = " padded "
+# trailing comment
+`,
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			sections, err := Parse(strings.NewReader(c), "")
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			var buf bytes.Buffer
+			if err := Write(&buf, sections); err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c, buf.String(); e != a {
+				t.Errorf("expect round trip\n%s\ngot\n%s", e, a)
+			}
+		})
+	}
+}
+
+func TestWriteModified(t *testing.T) {
+	sections, err := Parse(strings.NewReader(`# keep me
+[default]
+subdomain = old # tenant
+domain = cyberark.cloud
+`), "")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	section, _ := sections.GetSection("default")
+	v, _ := NewStringValue("new")
+	section.UpdateValue("subdomain", v)
+	section.DeleteValue("domain")
+	v, _ = NewStringValue("/path/to/ca.pem")
+	section.UpdateValue("ca_bundle", v)
+
+	added := NewSection("services local")
+	v, _ = NewMapValue(map[string]string{"endpoint_url": "http://localhost"})
+	added.UpdateValue("generic", v)
+	sections.SetSection(added.Name, added)
+
+	var buf bytes.Buffer
+	if err := Write(&buf, sections); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	expect := `# keep me
+[default]
+subdomain = new # tenant
+ca_bundle = /path/to/ca.pem
+
+[services local]
+generic =
+  endpoint_url = http://localhost
+`
+	if e, a := expect, buf.String(); e != a {
+		t.Errorf("expect\n%s\ngot\n%s", e, a)
+	}
+}
+
+func TestWriteFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dir", "config")
+
+	sections := NewSections()
+	section := NewSection("default")
+	v, _ := NewStringValue("value")
+	section.UpdateValue("key", v)
+	sections.SetSection(section.Name, section)
+
+	if err := WriteFile(path, sections, 0600); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "[default]\nkey = value\n", string(b); e != a {
+		t.Errorf("expect %q, got %q", e, a)
+	}
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := 1, len(entries); e != a {
+		t.Errorf("expect %v files, no temporary files left behind, got %v", e, a)
+	}
+
+	if runtime.GOOS == "windows" {
+		return
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := os.FileMode(0600), info.Mode().Perm(); e != a {
+		t.Errorf("expect file mode %v, got %v", e, a)
+	}
+	info, err = os.Stat(filepath.Dir(path))
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := os.FileMode(0700), info.Mode().Perm(); e != a {
+		t.Errorf("expect directory mode %v, got %v", e, a)
+	}
+}
@@ -0,0 +1,89 @@
+// Package validation provides the parameter validation errors of the SDK's
+// generated operation input validators, in addition to the errors provided by
+// smithy-go.
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	smithy "github.com/strick-j/smithy-go"
+)
+
+// ParamEnumValueError represents a parameter error for a value that is not
+// one of the values of the parameter's enum.
+type ParamEnumValueError struct {
+	context       string
+	nestedContext string
+	field         string
+	value         string
+	allowed       []string
+}
+
+var _ smithy.InvalidParamError = (*ParamEnumValueError)(nil)
+
+// NewErrParamEnumValue creates a new enum value parameter error.
+func NewErrParamEnumValue(field, value string, allowed []string) *ParamEnumValueError {
+	return &ParamEnumValueError{
+		field:   field,
+		value:   value,
+		allowed: allowed,
+	}
+}
+
+// Error returns the string version of the enum value parameter error.
+func (e *ParamEnumValueError) Error() string {
+	return fmt.Sprintf("invalid enum value %q, must be one of [%s], %s.",
+		e.value, strings.Join(e.allowed, ", "), e.Field())
+}
+
+// Field returns the field and context the error occurred.
+func (e *ParamEnumValueError) Field() string {
+	sb := &strings.Builder{}
+	sb.WriteString(e.context)
+	if sb.Len() > 0 {
+		if len(e.nestedContext) == 0 || e.nestedContext[:1] != "[" {
+			sb.WriteRune('.')
+		}
+	}
+	if len(e.nestedContext) > 0 {
+		sb.WriteString(e.nestedContext)
+		sb.WriteRune('.')
+	}
+	sb.WriteString(e.field)
+	return sb.String()
+}
+
+// SetContext updates the base context of the error.
+func (e *ParamEnumValueError) SetContext(ctx string) {
+	e.context = ctx
+}
+
+// AddNestedContext prepends a context to the field's path.
+func (e *ParamEnumValueError) AddNestedContext(ctx string) {
+	if len(e.nestedContext) == 0 {
+		e.nestedContext = ctx
+		return
+	}
+	if e.nestedContext[:1] != "[" {
+		e.nestedContext = fmt.Sprintf("%s.%s", ctx, e.nestedContext)
+		return
+	}
+	e.nestedContext = ctx + e.nestedContext
+}
+
+// EnumValue returns a ParamEnumValueError if the value is set and is not one
+// of the allowed values, otherwise nil.
+func EnumValue[T ~string](field string, value T, allowed []T) smithy.InvalidParamError {
+	if len(value) == 0 {
+		return nil
+	}
+	names := make([]string, len(allowed))
+	for i, v := range allowed {
+		if v == value {
+			return nil
+		}
+		names[i] = string(v)
+	}
+	return NewErrParamEnumValue(field, string(value), names)
+}
@@ -0,0 +1,33 @@
+package validation
+
+import (
+	"testing"
+
+	smithy "github.com/strick-j/smithy-go"
+)
+
+type testEnum string
+
+func TestEnumValue(t *testing.T) {
+	allowed := []testEnum{"a", "b"}
+
+	if err := EnumValue("Field", testEnum("a"), allowed); err != nil {
+		t.Errorf("expect no error for allowed value, got %v", err)
+	}
+	if err := EnumValue("Field", testEnum(""), allowed); err != nil {
+		t.Errorf("expect no error for unset value, got %v", err)
+	}
+
+	invalidParams := smithy.InvalidParamsError{Context: "Input"}
+	nested := smithy.InvalidParamsError{Context: "Nested"}
+	nested.Add(EnumValue("Field", testEnum("c"), allowed))
+	invalidParams.AddNested("Member", nested)
+	invalidParams.Add(smithy.NewErrParamRequired("Required"))
+
+	expect := "2 validation error(s) found.\n" +
+		"- invalid enum value \"c\", must be one of [a, b], Input.Member.Field.\n" +
+		"- missing required field, Input.Required.\n"
+	if e, a := expect, invalidParams.Error(); e != a {
+		t.Errorf("expect %q, got %q", e, a)
+	}
+}
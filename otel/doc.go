@@ -0,0 +1,11 @@
+// Package otel provides OpenTelemetry adapters for the SDK's tracing.Tracer
+// and tracing.Meter interfaces. The adapters are provided in a separate module
+// so applications not using OpenTelemetry do not depend on it.
+//
+//	cfg, err := config.LoadDefaultConfig(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	cfg.Tracer = otel.NewTracer(otelapi.GetTracerProvider())
+//	cfg.Meter = otel.NewMeter(otelapi.GetMeterProvider())
+package otel
@@ -0,0 +1,21 @@
+module github.com/strick-j/cybr-sdk-alpha/otel
+
+go 1.21.4
+
+require (
+	github.com/strick-j/cybr-sdk-alpha v0.0.0-20261018132437-eb26b85fc8f1
+	go.opentelemetry.io/otel v1.21.0
+	go.opentelemetry.io/otel/metric v1.21.0
+	go.opentelemetry.io/otel/sdk v1.21.0
+	go.opentelemetry.io/otel/trace v1.21.0
+)
+
+require (
+	github.com/go-logr/logr v1.3.0 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
+	golang.org/x/sys v0.14.0 // indirect
+)
+
+// The replace directive is only used when developing the module within the
+// repository, and is ignored by the modules depending on this module.
+replace github.com/strick-j/cybr-sdk-alpha => ../
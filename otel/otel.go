@@ -0,0 +1,104 @@
+package otel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/strick-j/cybr-sdk-alpha/cybr/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ScopeName is the instrumentation scope name of the tracer and meter
+// created by the adapters.
+const ScopeName = "github.com/strick-j/cybr-sdk-alpha"
+
+// NewTracer returns a tracing.Tracer that records spans with a tracer
+// obtained from the OpenTelemetry TracerProvider.
+func NewTracer(provider trace.TracerProvider) tracing.Tracer {
+	return &tracer{tracer: provider.Tracer(ScopeName)}
+}
+
+type tracer struct {
+	tracer trace.Tracer
+}
+
+func (t *tracer) StartSpan(ctx context.Context, name string, attrs ...tracing.Attribute) (context.Context, tracing.Span) {
+	ctx, s := t.tracer.Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(toKeyValues(attrs)...),
+	)
+	return ctx, &span{span: s}
+}
+
+type span struct {
+	span trace.Span
+}
+
+func (s *span) SetAttributes(attrs ...tracing.Attribute) {
+	s.span.SetAttributes(toKeyValues(attrs)...)
+}
+
+func (s *span) SetError(err error) {
+	s.span.RecordError(err)
+	s.span.SetStatus(codes.Error, err.Error())
+}
+
+func (s *span) End() {
+	s.span.End()
+}
+
+// NewMeter returns a tracing.Meter that records metrics with a meter
+// obtained from the OpenTelemetry MeterProvider.
+func NewMeter(provider metric.MeterProvider) tracing.Meter {
+	return &meter{meter: provider.Meter(ScopeName)}
+}
+
+type meter struct {
+	meter metric.Meter
+}
+
+// Histogram returns a histogram recording to an OpenTelemetry float64
+// histogram. If the histogram cannot be created a histogram that does not
+// record values is returned.
+func (m *meter) Histogram(name, unit, description string) tracing.Histogram {
+	h, err := m.meter.Float64Histogram(name,
+		metric.WithUnit(unit),
+		metric.WithDescription(description),
+	)
+	if err != nil {
+		return tracing.NopMeter{}.Histogram(name, unit, description)
+	}
+	return &histogram{histogram: h}
+}
+
+type histogram struct {
+	histogram metric.Float64Histogram
+}
+
+func (h *histogram) Record(ctx context.Context, value float64, attrs ...tracing.Attribute) {
+	h.histogram.Record(ctx, value, metric.WithAttributes(toKeyValues(attrs)...))
+}
+
+func toKeyValues(attrs []tracing.Attribute) []attribute.KeyValue {
+	kvs := make([]attribute.KeyValue, 0, len(attrs))
+	for _, a := range attrs {
+		switch v := a.Value.(type) {
+		case string:
+			kvs = append(kvs, attribute.String(a.Key, v))
+		case bool:
+			kvs = append(kvs, attribute.Bool(a.Key, v))
+		case int:
+			kvs = append(kvs, attribute.Int(a.Key, v))
+		case int64:
+			kvs = append(kvs, attribute.Int64(a.Key, v))
+		case float64:
+			kvs = append(kvs, attribute.Float64(a.Key, v))
+		default:
+			kvs = append(kvs, attribute.String(a.Key, fmt.Sprint(v)))
+		}
+	}
+	return kvs
+}
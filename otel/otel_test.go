@@ -0,0 +1,54 @@
+package otel
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/strick-j/cybr-sdk-alpha/cybr/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracer(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	tracer := NewTracer(provider)
+
+	_, span := tracer.StartSpan(context.Background(), "Generic.GetPlatformToken",
+		tracing.String(tracing.ServiceIDKey, "Generic"),
+	)
+	span.SetAttributes(tracing.Int(tracing.StatusCodeKey, 400))
+	span.SetError(fmt.Errorf("bad request"))
+	span.End()
+
+	spans := recorder.Ended()
+	if e, a := 1, len(spans); e != a {
+		t.Fatalf("expect %v spans, got %v", e, a)
+	}
+
+	s := spans[0]
+	if e, a := "Generic.GetPlatformToken", s.Name(); e != a {
+		t.Errorf("expect %v name, got %v", e, a)
+	}
+	if e, a := codes.Error, s.Status().Code; e != a {
+		t.Errorf("expect %v status, got %v", e, a)
+	}
+
+	expect := map[attribute.Key]attribute.Value{
+		tracing.ServiceIDKey:  attribute.StringValue("Generic"),
+		tracing.StatusCodeKey: attribute.IntValue(400),
+	}
+	for _, kv := range s.Attributes() {
+		if v, ok := expect[kv.Key]; ok && v != kv.Value {
+			t.Errorf("expect %v to be %v, got %v", kv.Key, v.Emit(), kv.Value.Emit())
+		}
+		delete(expect, kv.Key)
+	}
+	if len(expect) != 0 {
+		t.Errorf("expect attributes %v to be recorded", expect)
+	}
+}
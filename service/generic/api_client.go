@@ -3,10 +3,12 @@ package generic
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/strick-j/cybr-sdk-alpha/cybr"
 	cybrmiddleware "github.com/strick-j/cybr-sdk-alpha/cybr/middleware"
 	cybrhttp "github.com/strick-j/cybr-sdk-alpha/cybr/transport/http"
+	internalConfig "github.com/strick-j/cybr-sdk-alpha/internal/configsources"
 	smithy "github.com/strick-j/smithy-go"
 	"github.com/strick-j/smithy-go/logging"
 	"github.com/strick-j/smithy-go/middleware"
@@ -18,6 +20,11 @@ const ServiceID = "Generic"
 // Client provides the API client to make operations call for "generic" service.
 type Client struct {
 	options Options
+
+	// The decorated handlers of the operations invoked without per-call
+	// options, by operation ID. The handlers are built once, with the
+	// client's options, and reused by the subsequent calls.
+	handlers sync.Map
 }
 
 // New returns an initialized Client based on the functional options. Provide
@@ -36,6 +43,8 @@ func New(options Options, optFns ...func(*Options)) *Client {
 		fn(&options)
 	}
 
+	warnDeprecatedEndpointResolver(options)
+
 	client := &Client{
 		options: options,
 	}
@@ -49,26 +58,21 @@ func (c *Client) Options() Options {
 
 func (c *Client) invokeOperation(ctx context.Context, opID string, params interface{}, optFns []func(*Options), stackFns ...func(*middleware.Stack, Options) error) (result interface{}, metadata middleware.Metadata, err error) {
 	ctx = middleware.ClearStackValues(ctx)
-	stack := middleware.NewStack(opID, smithyhttp.NewStackRequest)
-	options := c.options.Copy()
-
-	for _, fn := range optFns {
-		fn(&options)
-	}
 
-	for _, fn := range stackFns {
-		if err := fn(stack, options); err != nil {
-			return nil, metadata, err
+	var handler middleware.Handler
+	if len(optFns) == 0 {
+		handler, err = c.cachedOperationHandler(opID, stackFns)
+	} else {
+		options := c.options.Copy()
+		for _, fn := range optFns {
+			fn(&options)
 		}
+		handler, err = newOperationHandler(opID, options, stackFns)
 	}
-
-	for _, fn := range options.APIOptions {
-		if err := fn(stack); err != nil {
-			return nil, metadata, err
-		}
+	if err != nil {
+		return nil, metadata, err
 	}
 
-	handler := middleware.DecorateHandler(smithyhttp.NewClientHandler(options.HTTPClient), stack)
 	result, metadata, err = handler.Handle(ctx, params)
 	if err != nil {
 		err = &smithy.OperationError{
@@ -80,6 +84,46 @@ func (c *Client) invokeOperation(ctx context.Context, opID string, params interf
 	return result, metadata, err
 }
 
+// cachedOperationHandler returns the decorated handler of the operation built
+// with the client's options, building it on the operation's first call. The
+// middleware of the handler must be safe for concurrent use, as the handler
+// is shared by the concurrent calls of the operation.
+func (c *Client) cachedOperationHandler(opID string, stackFns []func(*middleware.Stack, Options) error) (middleware.Handler, error) {
+	if handler, ok := c.handlers.Load(opID); ok {
+		return handler.(middleware.Handler), nil
+	}
+
+	handler, err := newOperationHandler(opID, c.options.Copy(), stackFns)
+	if err != nil {
+		return nil, err
+	}
+
+	// Concurrent first calls may each build a handler, only one of which is
+	// kept.
+	cached, _ := c.handlers.LoadOrStore(opID, handler)
+	return cached.(middleware.Handler), nil
+}
+
+// newOperationHandler builds the middleware stack of the operation with the
+// options, returning the handler decorated with the stack.
+func newOperationHandler(opID string, options Options, stackFns []func(*middleware.Stack, Options) error) (middleware.Handler, error) {
+	stack := middleware.NewStack(opID, smithyhttp.NewStackRequest)
+
+	for _, fn := range stackFns {
+		if err := fn(stack, options); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, fn := range options.APIOptions {
+		if err := fn(stack); err != nil {
+			return nil, err
+		}
+	}
+
+	return middleware.DecorateHandler(smithyhttp.NewClientHandler(options.HTTPClient), stack), nil
+}
+
 type operationInputKey struct{}
 
 func setOperationInput(ctx context.Context, input interface{}) context.Context {
@@ -105,9 +149,15 @@ func (m *setOperationInputMiddleware) HandleSerialize(ctx context.Context, in mi
 }
 
 func addProtocolFinalizerMiddlewares(stack *middleware.Stack, options Options, operation string) error {
-	if err := stack.Finalize.Insert(&resolveEndpointV2Middleware{options: options}, "GetIdentity", middleware.After); err != nil {
+	if err := stack.Finalize.Add(&resolveEndpointV2Middleware{options: options}, middleware.Before); err != nil {
 		return fmt.Errorf("add ResolveEndpointV2: %v", err)
 	}
+	if err := addGetIdentityMiddleware(stack, options, operation); err != nil {
+		return fmt.Errorf("add GetIdentity: %v", err)
+	}
+	if err := addSignRequestMiddleware(stack, options); err != nil {
+		return fmt.Errorf("add Signing: %v", err)
+	}
 	return nil
 }
 
@@ -146,10 +196,66 @@ func NewFromConfig(cfg cybr.Config, optFns ...func(*Options)) *Client {
 		APIOptions:    cfg.APIOptions,
 		Logger:        cfg.Logger,
 		ClientLogMode: cfg.ClientLogMode,
+		RateLimiter:   cfg.RateLimiter,
+		Tracer:        cfg.Tracer,
+		Meter:         cfg.Meter,
+		Credentials:   cfg.Credentials,
+		BaseEndpoint:  cfg.BaseEndpoint,
+		AppID:         cfg.AppID,
 	}
+	resolveCYBREndpointResolver(cfg, &opts)
+	resolveBaseEndpoint(cfg, &opts)
 	return New(opts, optFns...)
 }
 
+// resolveBaseEndpoint sets the client's BaseEndpoint, the service-specific
+// endpoint configured in the config sources taking precedence over the
+// Config's BaseEndpoint.
+func resolveBaseEndpoint(cfg cybr.Config, o *Options) {
+	if cfg.BaseEndpoint != nil {
+		o.BaseEndpoint = cfg.BaseEndpoint
+	}
+
+	value, found, err := internalConfig.ResolveServiceBaseEndpoint(context.Background(), ServiceID, cfg.ConfigSources)
+	if found && err == nil {
+		o.BaseEndpoint = &value
+	}
+}
+
+func resolveCYBREndpointResolver(cfg cybr.Config, o *Options) {
+	if cfg.EndpointResolver == nil && cfg.EndpointResolverWithOptions == nil {
+		return
+	}
+	o.EndpointResolver = withEndpointResolver(cfg.EndpointResolver, cfg.EndpointResolverWithOptions)
+}
+
+var deprecatedEndpointResolverOnce sync.Once
+
+// warnDeprecatedEndpointResolver logs a warning, once per process, if the
+// deprecated EndpointResolver is configured and deprecated usage logging is
+// enabled.
+func warnDeprecatedEndpointResolver(o Options) {
+	if o.EndpointResolver == nil || !o.ClientLogMode.IsDeprecatedUsage() {
+		return
+	}
+	deprecatedEndpointResolverOnce.Do(func() {
+		o.Logger.Logf(logging.Warn, "the deprecated EndpointResolver and EndpointResolverWithOptions "+
+			"options are configured, use EndpointResolverV2 or BaseEndpoint instead")
+	})
+}
+
+func addRateLimitMiddleware(stack *middleware.Stack, o Options) error {
+	return cybrmiddleware.AddRateLimitMiddleware(stack, o.RateLimiter)
+}
+
+func addUserAgentMiddleware(stack *middleware.Stack, o Options) error {
+	return cybrmiddleware.AddUserAgentMiddleware(stack, o.AppID)
+}
+
+func addTracingMiddleware(stack *middleware.Stack, o Options) error {
+	return cybrmiddleware.AddTracingMiddleware(stack, o.Tracer, o.Meter)
+}
+
 func addRequestIDRetrieverMiddleware(stack *middleware.Stack) error {
 	return cybrmiddleware.AddRequestIDRetrieverMiddleware(stack)
 }
@@ -159,10 +265,10 @@ func addResponseErrorMiddleware(stack *middleware.Stack) error {
 }
 
 func addRequestResponseLogging(stack *middleware.Stack, o Options) error {
-	return stack.Deserialize.Add(&smithyhttp.RequestResponseLogger{
+	return cybrhttp.AddRequestResponseLogger(stack, &cybrhttp.RequestResponseLogger{
 		LogRequest:          o.ClientLogMode.IsRequest(),
 		LogRequestWithBody:  o.ClientLogMode.IsRequestWithBody(),
 		LogResponse:         o.ClientLogMode.IsResponse(),
 		LogResponseWithBody: o.ClientLogMode.IsResponseWithBody(),
-	}, middleware.After)
+	})
 }
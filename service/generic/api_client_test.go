@@ -0,0 +1,101 @@
+package generic
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/strick-j/smithy-go/middleware"
+	smithyhttp "github.com/strick-j/smithy-go/transport/http"
+)
+
+// clientIDKey is the stack value key of the client ID of the call.
+type clientIDKey struct{}
+
+func TestClient_ConcurrentCallsStatefulAPIOption(t *testing.T) {
+	var runs, requests int32
+	var mu sync.Mutex
+	sent := map[string]int{}
+	countRequests := func(stack *middleware.Stack) error {
+		atomic.AddInt32(&runs, 1)
+		if err := stack.Initialize.Add(middleware.InitializeMiddlewareFunc("SetClientID", func(
+			ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler,
+		) (middleware.InitializeOutput, middleware.Metadata, error) {
+			ctx = middleware.WithStackValue(ctx, clientIDKey{}, in.Parameters.(*GetPlatformTokenInput).ClientId)
+			return next.HandleInitialize(ctx, in)
+		}), middleware.After); err != nil {
+			return err
+		}
+		return stack.Build.Add(middleware.BuildMiddlewareFunc("CountRequests", func(
+			ctx context.Context, in middleware.BuildInput, next middleware.BuildHandler,
+		) (middleware.BuildOutput, middleware.Metadata, error) {
+			atomic.AddInt32(&requests, 1)
+			req := in.Request.(*smithyhttp.Request)
+			req.Header.Set("X-Client-Id", middleware.GetStackValue(ctx, clientIDKey{}).(string))
+			return next.HandleBuild(ctx, in)
+		}), middleware.After)
+	}
+
+	client := New(Options{
+		Subdomain:  "example",
+		APIOptions: []func(*middleware.Stack) error{countRequests},
+		HTTPClient: smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+			mu.Lock()
+			sent[r.Header.Get("X-Client-Id")]++
+			mu.Unlock()
+			return &http.Response{
+				StatusCode: 200,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(`{}`)),
+			}, nil
+		}),
+	})
+
+	getToken := func(clientID string) {
+		_, err := client.GetPlatformToken(context.Background(), &GetPlatformTokenInput{
+			ClientId:     clientID,
+			ClientSecret: "client-secret",
+		})
+		if err != nil {
+			t.Errorf("expect no error, got %v", err)
+		}
+	}
+
+	getToken("client-id")
+
+	const goroutines, calls = 16, 10
+	var wg sync.WaitGroup
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < calls; j++ {
+				getToken(fmt.Sprintf("client-%d-%d", i, j))
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if e, a := int32(1), atomic.LoadInt32(&runs); e != a {
+		t.Errorf("expect APIOptions run %v times, got %v", e, a)
+	}
+	if e, a := int32(goroutines*calls+1), atomic.LoadInt32(&requests); e != a {
+		t.Errorf("expect %v requests counted, got %v", e, a)
+	}
+
+	// Each call's client ID is kept in its own context, and set on its own
+	// request.
+	if e, a := goroutines*calls+1, len(sent); e != a {
+		t.Errorf("expect %v distinct client ID headers, got %v", e, a)
+	}
+	for clientID, n := range sent {
+		if n != 1 {
+			t.Errorf("expect %q client ID header sent once, got %v", clientID, n)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	cybrmiddleware "github.com/strick-j/cybr-sdk-alpha/cybr/middleware"
+	"github.com/strick-j/cybr-sdk-alpha/service/generic/types"
 	"github.com/strick-j/smithy-go/middleware"
 	smithyhttp "github.com/strick-j/smithy-go/transport/http"
 )
@@ -28,15 +29,19 @@ func (c *Client) GetPlatformToken(ctx context.Context, params *GetPlatformTokenI
 
 type GetPlatformTokenInput struct {
 
-	// The grant type to use for the token request.
-	// Typically this should be client_credentials.
-	GrantType string
-
 	// The client ID to use for the token request.
+	//
+	// This member is required.
 	ClientId string
 
 	// The client secret to use for the token request.
+	//
+	// This member is required.
 	ClientSecret string
+
+	// The grant type to use for the token request. Defaults to
+	// client_credentials.
+	GrantType types.GrantType
 }
 
 type GetPlatformTokenOutput struct {
@@ -65,6 +70,12 @@ func (c *Client) addOperationGetPlatformTokenMiddleware(stack *middleware.Stack,
 	if err = cybrmiddleware.AddClientRequestIDMiddleware(stack); err != nil {
 		return err
 	}
+	if err = cybrmiddleware.AddRequestAttemptMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addUserAgentMiddleware(stack, options); err != nil {
+		return err
+	}
 	if err = smithyhttp.AddComputeContentLengthMiddleware(stack); err != nil {
 		return err
 	}
@@ -80,9 +91,9 @@ func (c *Client) addOperationGetPlatformTokenMiddleware(stack *middleware.Stack,
 	if err = smithyhttp.AddCloseResponseBodyMiddleware(stack); err != nil {
 		return err
 	}
-	//if err = addOpAddUserToGroupValidationMiddleware(stack); err != nil {
-	//	return err
-	//}
+	if err = addOpGetPlatformTokenValidationMiddleware(stack); err != nil {
+		return err
+	}
 	if err = stack.Initialize.Add(newServiceMetadataMiddleware_opGetPlatformToken(options.Subdomain, options.Domain), middleware.Before); err != nil {
 		return err
 	}
@@ -95,6 +106,12 @@ func (c *Client) addOperationGetPlatformTokenMiddleware(stack *middleware.Stack,
 	if err = addRequestResponseLogging(stack, options); err != nil {
 		return err
 	}
+	if err = addRateLimitMiddleware(stack, options); err != nil {
+		return err
+	}
+	if err = addTracingMiddleware(stack, options); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -104,6 +121,6 @@ func newServiceMetadataMiddleware_opGetPlatformToken(subdomain string, domain st
 		Subdomain:     subdomain,
 		Domain:        domain,
 		ServiceID:     ServiceID,
-		OperationName: "AddUserToGroup",
+		OperationName: "GetPlatformToken",
 	}
 }
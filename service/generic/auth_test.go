@@ -0,0 +1,173 @@
+package generic
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/strick-j/cybr-sdk-alpha/cybr"
+	"github.com/strick-j/smithy-go/logging"
+	"github.com/strick-j/smithy-go/middleware"
+	smithyhttp "github.com/strick-j/smithy-go/transport/http"
+)
+
+func tokenProvider(retrieved *int) cybr.CredentialsProvider {
+	return cybr.CredentialsProviderFunc(func(context.Context) (cybr.Credentials, error) {
+		*retrieved++
+		return cybr.Credentials{SessionToken: "token"}, nil
+	})
+}
+
+// sendRequest sends a request of the operation through the client's finalize
+// middleware, and returns the request that would have been sent.
+func sendRequest(t *testing.T, options Options, operation string) *smithyhttp.Request {
+	t.Helper()
+
+	resolveEndpointResolverV2(&options)
+
+	stack := middleware.NewStack(operation, smithyhttp.NewStackRequest)
+	if err := addProtocolFinalizerMiddlewares(stack, options, operation); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	var sent *smithyhttp.Request
+	handler := middleware.DecorateHandler(middleware.HandlerFunc(
+		func(ctx context.Context, in interface{}) (interface{}, middleware.Metadata, error) {
+			sent = in.(*smithyhttp.Request)
+			return &smithyhttp.Response{Response: &http.Response{StatusCode: 200}}, middleware.Metadata{}, nil
+		}), stack)
+
+	if _, _, err := handler.Handle(context.Background(), struct{}{}); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	return sent
+}
+
+func TestGetPlatformToken_Unsigned(t *testing.T) {
+	var retrieved int
+	var header http.Header
+	client := New(Options{
+		Subdomain:   "example",
+		Credentials: tokenProvider(&retrieved),
+		HTTPClient: smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+			header = r.Header.Clone()
+			return &http.Response{
+				StatusCode: 200,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(`{}`)),
+			}, nil
+		}),
+	})
+
+	_, err := client.GetPlatformToken(context.Background(), &GetPlatformTokenInput{
+		ClientId:     "client-id",
+		ClientSecret: "client-secret",
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if v := header.Get("Authorization"); len(v) != 0 {
+		t.Errorf("expect no Authorization header, got %q", v)
+	}
+	if e, a := 0, retrieved; e != a {
+		t.Errorf("expect credentials retrieved %v times, got %v", e, a)
+	}
+}
+
+func TestSignRequest(t *testing.T) {
+	cases := map[string]struct {
+		operation       string
+		credentials     func(*int) cybr.CredentialsProvider
+		expectHeader    string
+		expectRetrieved int
+	}{
+		"bearer token": {
+			operation:       "ListThings",
+			credentials:     tokenProvider,
+			expectHeader:    "Bearer token",
+			expectRetrieved: 1,
+		},
+		"no auth operation": {
+			operation:   "GetPlatformToken",
+			credentials: tokenProvider,
+		},
+		"anonymous credentials": {
+			operation: "ListThings",
+			credentials: func(*int) cybr.CredentialsProvider {
+				return cybr.AnonymousCredentials{}
+			},
+		},
+		"nil credentials": {
+			operation: "ListThings",
+			credentials: func(*int) cybr.CredentialsProvider {
+				return nil
+			},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var retrieved int
+			req := sendRequest(t, Options{
+				Subdomain:   "example",
+				Credentials: c.credentials(&retrieved),
+			}, c.operation)
+
+			if e, a := c.expectHeader, req.Header.Get("Authorization"); e != a {
+				t.Errorf("expect %q Authorization header, got %q", e, a)
+			}
+			if e, a := c.expectRetrieved, retrieved; e != a {
+				t.Errorf("expect credentials retrieved %v times, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestWarnDeprecatedEndpointResolver(t *testing.T) {
+	cases := map[string]struct {
+		resolver   EndpointResolver
+		logMode    cybr.ClientLogMode
+		expectWarn int
+	}{
+		"deprecated resolver": {
+			resolver:   EndpointResolverFromURL("https://deprecated.example.com"),
+			logMode:    cybr.LogDeprecatedUsage,
+			expectWarn: 1,
+		},
+		"deprecated usage not logged": {
+			resolver: EndpointResolverFromURL("https://deprecated.example.com"),
+		},
+		"no deprecated resolver": {
+			logMode: cybr.LogDeprecatedUsage,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			deprecatedEndpointResolverOnce = sync.Once{}
+
+			var warnings int
+			logger := logging.LoggerFunc(func(classification logging.Classification, format string, v ...interface{}) {
+				if classification == logging.Warn {
+					warnings++
+				}
+			})
+			for i := 0; i < 2; i++ {
+				New(Options{
+					Subdomain:        "example",
+					EndpointResolver: c.resolver,
+					ClientLogMode:    c.logMode,
+					Logger:           logger,
+				})
+			}
+
+			if e, a := c.expectWarn, warnings; e != a {
+				t.Errorf("expect %v warnings, got %v", e, a)
+			}
+		})
+	}
+}
@@ -188,7 +188,7 @@ type EndpointParameters struct {
 	// Override the endpoint used to send this request
 	//
 	// Parameter is
-	// required.
+	// optional.
 	//
 	// SDK::Endpoint
 	Endpoint *string
@@ -230,6 +230,30 @@ func (r *resolver) ResolveEndpoint(
 	if err = params.ValidateRequired(); err != nil {
 		return endpoint, fmt.Errorf("endpoint parameters are not valid, %w", err)
 	}
+	if exprVal := params.Endpoint; exprVal != nil {
+		_Endpoint := *exprVal
+		uri, err := url.Parse(_Endpoint)
+		if err != nil {
+			return endpoint, fmt.Errorf("failed to parse endpoint %s, %w", _Endpoint, err)
+		}
+		if uri.Scheme != "https" && uri.Scheme != "http" {
+			return endpoint, fmt.Errorf("endpoint %s must use the http or https scheme", _Endpoint)
+		}
+		if len(uri.Host) == 0 {
+			return endpoint, fmt.Errorf("endpoint %s must include a host", _Endpoint)
+		}
+		uri.RawQuery = ""
+		uri.Fragment = ""
+
+		return smithyendpoints.Endpoint{
+			URI:     *uri,
+			Headers: http.Header{},
+		}, nil
+	}
+
+	if params.Subdomain == nil || len(*params.Subdomain) == 0 {
+		return endpoint, fmt.Errorf("endpoint parameters are not valid, parameter Subdomain is required")
+	}
 	_Domain := *params.Domain
 	_Subdomain := *params.Subdomain
 
@@ -244,7 +268,7 @@ func (r *resolver) ResolveEndpoint(
 
 	uri, err := url.Parse(uriString)
 	if err != nil {
-		return endpoint, fmt.Errorf("Failed to parse uri: %s", uriString)
+		return endpoint, fmt.Errorf("failed to parse endpoint %s, %w", uriString, err)
 	}
 
 	return smithyendpoints.Endpoint{
@@ -260,8 +284,13 @@ type endpointParamsBinder interface {
 func bindEndpointParams(input interface{}, options Options) *EndpointParameters {
 	params := &EndpointParameters{}
 
-	params.Domain = cybr.String(options.Domain)
-	params.Subdomain = cybr.String(options.Subdomain)
+	if len(options.Domain) != 0 {
+		params.Domain = cybr.String(options.Domain)
+	}
+	if len(options.Subdomain) != 0 {
+		params.Subdomain = cybr.String(options.Subdomain)
+	}
+	params.Endpoint = options.BaseEndpoint
 
 	if b, ok := input.(endpointParamsBinder); ok {
 		b.bindEndpointParams(params)
@@ -0,0 +1,147 @@
+package generic
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/strick-j/cybr-sdk-alpha/cybr"
+	smithyhttp "github.com/strick-j/smithy-go/transport/http"
+)
+
+func TestEndpointResolverV2(t *testing.T) {
+	cases := map[string]struct {
+		params      EndpointParameters
+		expectURI   string
+		expectError string
+	}{
+		"subdomain": {
+			params:    EndpointParameters{Subdomain: cybr.String("example")},
+			expectURI: "https://example.cyberark.cloud",
+		},
+		"subdomain and domain": {
+			params: EndpointParameters{
+				Subdomain: cybr.String("example"),
+				Domain:    cybr.String("cyberark.example"),
+			},
+			expectURI: "https://example.cyberark.example",
+		},
+		"missing subdomain": {
+			params:      EndpointParameters{},
+			expectError: "parameter Subdomain is required",
+		},
+		"base endpoint takes precedence": {
+			params: EndpointParameters{
+				Subdomain: cybr.String("example"),
+				Endpoint:  cybr.String("https://proxy.example.com"),
+			},
+			expectURI: "https://proxy.example.com",
+		},
+		"base endpoint without subdomain": {
+			params:    EndpointParameters{Endpoint: cybr.String("http://localhost:8080")},
+			expectURI: "http://localhost:8080",
+		},
+		"base endpoint with path": {
+			params:    EndpointParameters{Endpoint: cybr.String("https://proxy.example.com/cyberark")},
+			expectURI: "https://proxy.example.com/cyberark",
+		},
+		"base endpoint with trailing slash": {
+			params:    EndpointParameters{Endpoint: cybr.String("https://proxy.example.com/cyberark/")},
+			expectURI: "https://proxy.example.com/cyberark/",
+		},
+		"base endpoint query and fragment are dropped": {
+			params:    EndpointParameters{Endpoint: cybr.String("https://proxy.example.com/cyberark?a=b#c")},
+			expectURI: "https://proxy.example.com/cyberark",
+		},
+		"base endpoint with non http scheme": {
+			params:      EndpointParameters{Endpoint: cybr.String("ftp://proxy.example.com")},
+			expectError: "must use the http or https scheme",
+		},
+		"base endpoint without scheme": {
+			params:      EndpointParameters{Endpoint: cybr.String("proxy.example.com")},
+			expectError: "must use the http or https scheme",
+		},
+		"base endpoint not a URL": {
+			params:      EndpointParameters{Endpoint: cybr.String("https://proxy.example.com:port")},
+			expectError: "failed to parse endpoint https://proxy.example.com:port",
+		},
+		"base endpoint without host": {
+			params:      EndpointParameters{Endpoint: cybr.String("https:///cyberark")},
+			expectError: "must include a host",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			endpoint, err := NewDefaultEndpointResolverV2().ResolveEndpoint(context.Background(), c.params)
+			if len(c.expectError) != 0 {
+				if err == nil {
+					t.Fatalf("expect error, got none")
+				}
+				if e, a := c.expectError, err.Error(); !strings.Contains(a, e) {
+					t.Errorf("expect %q error, got %q", e, a)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			if e, a := c.expectURI, endpoint.URI.String(); e != a {
+				t.Errorf("expect %v URI, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestClient_BaseEndpoint(t *testing.T) {
+	cases := map[string]struct {
+		baseEndpoint string
+		expectURL    string
+	}{
+		"host": {
+			baseEndpoint: "https://proxy.example.com",
+			expectURL:    "https://proxy.example.com/oauth2/platformtoken",
+		},
+		"path": {
+			baseEndpoint: "https://proxy.example.com/cyberark",
+			expectURL:    "https://proxy.example.com/cyberark/oauth2/platformtoken",
+		},
+		"trailing slash": {
+			baseEndpoint: "https://proxy.example.com/cyberark/",
+			expectURL:    "https://proxy.example.com/cyberark/oauth2/platformtoken",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var sent string
+			client := New(Options{
+				Subdomain:    "example",
+				BaseEndpoint: cybr.String(c.baseEndpoint),
+				HTTPClient: smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+					sent = r.URL.String()
+					return &http.Response{
+						StatusCode: 200,
+						Header:     http.Header{},
+						Body:       io.NopCloser(strings.NewReader(`{}`)),
+					}, nil
+				}),
+			})
+
+			_, err := client.GetPlatformToken(context.Background(), &GetPlatformTokenInput{
+				ClientId:     "client-id",
+				ClientSecret: "client-secret",
+			})
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			if e, a := c.expectURL, sent; e != a {
+				t.Errorf("expect %v URL, got %v", e, a)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/strick-j/cybr-sdk-alpha/cybr"
+	"github.com/strick-j/cybr-sdk-alpha/cybr/tracing"
 	"github.com/strick-j/smithy-go/logging"
 	"github.com/strick-j/smithy-go/middleware"
 )
@@ -16,8 +17,29 @@ type Options struct {
 	// Set of options to modify how an operation is invoked. These apply to all
 	// operations invoked for this client. Use functional options on operation call to
 	// modify this list for per operation behavior.
+	//
+	// The middleware stack of an operation invoked without functional options is
+	// built once and reused by the subsequent calls of the operation. The
+	// APIOptions are therefore run once per client and operation, on the
+	// operation's first call, rather than on every call, and the middleware
+	// they add is shared by the concurrent calls of the operation, so it must be
+	// safe for concurrent use. Per call state is kept in the context, e.g. with
+	// middleware.WithStackValue, rather than in the middleware. The APIOptions
+	// of an operation invoked with functional options are run on every call.
 	APIOptions []func(*middleware.Stack) error
 
+	// The ID of the application using the client, included in the User-Agent
+	// of the requests.
+	AppID string
+
+	// The credentials object to use when signing requests.
+	Credentials cybr.CredentialsProvider
+
+	// This endpoint will be given as input to an EndpointResolverV2. It is used
+	// for providing a custom base endpoint that is subject to modifications by
+	// the processing EndpointResolverV2.
+	BaseEndpoint *string
+
 	// The Domain to use for the API client.
 	Domain string
 
@@ -49,6 +71,16 @@ type Options struct {
 	// The HTTP client to invoke API calls with. Defaults to client's default HTTP
 	// implementation if nil.
 	HTTPClient HTTPClient
+
+	// The client side rate limiter requests are sent through. Client side rate
+	// limiting is disabled if nil.
+	RateLimiter cybr.RateLimiter
+
+	// The tracer used to record spans of the operations invoked.
+	Tracer tracing.Tracer
+
+	// The meter used to record metrics of the operations invoked.
+	Meter tracing.Meter
 }
 
 // Copy creates a clone where the APIOptions list is deep copied.
@@ -68,6 +100,32 @@ func WithAPIOptions(optFns ...func(*middleware.Stack) error) func(*Options) {
 	}
 }
 
+// WithRateLimiter returns a functional option for setting the Client's
+// RateLimiter option.
+func WithRateLimiter(v cybr.RateLimiter) func(*Options) {
+	return func(o *Options) {
+		o.RateLimiter = v
+	}
+}
+
+// WithBaseEndpoint returns a functional option for setting the Client's
+// BaseEndpoint option.
+func WithBaseEndpoint(v string) func(*Options) {
+	return func(o *Options) {
+		o.BaseEndpoint = &v
+	}
+}
+
+// WithEndpointResolver returns a functional option for setting the Client's
+// EndpointResolver option.
+//
+// Deprecated: See EndpointResolverV2 and WithEndpointResolverV2.
+func WithEndpointResolver(v EndpointResolver) func(*Options) {
+	return func(o *Options) {
+		o.EndpointResolver = v
+	}
+}
+
 // WithEndpointResolverV2 returns a functional option for setting the Client's
 // EndpointResolverV2 option.
 func WithEndpointResolverV2(v EndpointResolverV2) func(*Options) {
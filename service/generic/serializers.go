@@ -29,7 +29,6 @@ func (m *cybrQuery_serializeOpGetPlatformToken) HandleSerialize(ctx context.Cont
 	}
 
 	input, ok := in.Parameters.(*GetPlatformTokenInput)
-	_ = input
 	if !ok {
 		return out, metadata, &smithy.SerializationError{Err: fmt.Errorf("unknown input parameters type %T", in.Parameters)}
 	}
@@ -50,9 +49,6 @@ func (m *cybrQuery_serializeOpGetPlatformToken) HandleSerialize(ctx context.Cont
 	}
 	httpBindingEncoder.SetHeader("Content-Type").String("application/x-www-form-urlencoded")
 	httpBindingEncoder.SetHeader("Accept").String("application/json")
-	if len(input.ClientId) == 0 || len(input.ClientSecret) == 0 {
-		return out, metadata, &smithy.SerializationError{Err: fmt.Errorf("missing required parameter ClientId or ClientSecret for operation PlatformTokenAuth")}
-	}
 	request.SetBasicAuth(input.ClientId, input.ClientSecret)
 
 	bodyWriter := bytes.NewBuffer(nil)
@@ -61,7 +57,7 @@ func (m *cybrQuery_serializeOpGetPlatformToken) HandleSerialize(ctx context.Cont
 	if len(input.GrantType) == 0 {
 		body.Key("grant_type").String("client_credentials")
 	} else {
-		body.Key("grant_type").String(input.GrantType)
+		body.Key("grant_type").String(string(input.GrantType))
 	}
 
 	err = bodyEncoder.Encode()
@@ -0,0 +1,17 @@
+package types
+
+type GrantType string
+
+// Enum values for GrantType
+const (
+	GrantTypeClientCredentials GrantType = "client_credentials"
+)
+
+// Values returns all known values for GrantType. Note that this can be expanded
+// in the future, and so it is only as up to date as the client. The ordering of
+// this slice is not guaranteed to be stable across updates.
+func (GrantType) Values() []GrantType {
+	return []GrantType{
+		"client_credentials",
+	}
+}
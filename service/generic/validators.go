@@ -0,0 +1,56 @@
+package generic
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/strick-j/cybr-sdk-alpha/internal/validation"
+	"github.com/strick-j/cybr-sdk-alpha/service/generic/types"
+	smithy "github.com/strick-j/smithy-go"
+	"github.com/strick-j/smithy-go/middleware"
+)
+
+type validateOpGetPlatformToken struct {
+}
+
+func (*validateOpGetPlatformToken) ID() string {
+	return "OperationInputValidation"
+}
+
+func (m *validateOpGetPlatformToken) HandleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (
+	out middleware.InitializeOutput, metadata middleware.Metadata, err error,
+) {
+	input, ok := in.Parameters.(*GetPlatformTokenInput)
+	if !ok {
+		return out, metadata, fmt.Errorf("unknown input parameters type %T", in.Parameters)
+	}
+	if err := validateOpGetPlatformTokenInput(input); err != nil {
+		return out, metadata, err
+	}
+	return next.HandleInitialize(ctx, in)
+}
+
+func addOpGetPlatformTokenValidationMiddleware(stack *middleware.Stack) error {
+	return stack.Initialize.Add(&validateOpGetPlatformToken{}, middleware.After)
+}
+
+func validateOpGetPlatformTokenInput(v *GetPlatformTokenInput) error {
+	if v == nil {
+		return nil
+	}
+	invalidParams := smithy.InvalidParamsError{Context: "GetPlatformTokenInput"}
+	if err := validation.EnumValue("GrantType", v.GrantType, types.GrantType("").Values()); err != nil {
+		invalidParams.Add(err)
+	}
+	if len(v.ClientId) == 0 {
+		invalidParams.Add(smithy.NewErrParamRequired("ClientId"))
+	}
+	if len(v.ClientSecret) == 0 {
+		invalidParams.Add(smithy.NewErrParamRequired("ClientSecret"))
+	}
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	} else {
+		return nil
+	}
+}
@@ -0,0 +1,54 @@
+package generic
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/strick-j/cybr-sdk-alpha/internal/validation"
+	"github.com/strick-j/cybr-sdk-alpha/service/generic/types"
+	smithy "github.com/strick-j/smithy-go"
+)
+
+func TestValidateOpGetPlatformTokenInput(t *testing.T) {
+	cases := map[string]struct {
+		grantType   types.GrantType
+		expectError bool
+	}{
+		"empty grant type": {},
+		"valid grant type": {
+			grantType: types.GrantTypeClientCredentials,
+		},
+		"unknown grant type": {
+			grantType:   types.GrantType("password"),
+			expectError: true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := validateOpGetPlatformTokenInput(&GetPlatformTokenInput{
+				ClientId:     "client-id",
+				ClientSecret: "client-secret",
+				GrantType:    c.grantType,
+			})
+			if !c.expectError {
+				if err != nil {
+					t.Fatalf("expect no error, got %v", err)
+				}
+				return
+			}
+
+			var invalidParams smithy.InvalidParamsError
+			if !errors.As(err, &invalidParams) {
+				t.Fatalf("expect InvalidParamsError, got %T, %v", err, err)
+			}
+			var enumErr *validation.ParamEnumValueError
+			if !errors.As(invalidParams.Errs()[0], &enumErr) {
+				t.Fatalf("expect ParamEnumValueError, got %T, %v", invalidParams.Errs()[0], invalidParams.Errs()[0])
+			}
+			if e, a := "GetPlatformTokenInput.GrantType", enumErr.Field(); e != a {
+				t.Errorf("expect %v field, got %v", e, a)
+			}
+		})
+	}
+}
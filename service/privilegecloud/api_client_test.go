@@ -0,0 +1,825 @@
+package privilegecloud
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/strick-j/cybr-sdk-alpha/credentials"
+	"github.com/strick-j/cybr-sdk-alpha/cybr"
+	"github.com/strick-j/cybr-sdk-alpha/service/privilegecloud/types"
+	"github.com/strick-j/smithy-go/logging"
+	"github.com/strick-j/smithy-go/middleware"
+	smithyhttp "github.com/strick-j/smithy-go/transport/http"
+)
+
+type stubHTTPClient struct {
+	mu      sync.Mutex
+	headers []http.Header
+}
+
+func (c *stubHTTPClient) Do(r *http.Request) (*http.Response, error) {
+	c.mu.Lock()
+	c.headers = append(c.headers, r.Header.Clone())
+	c.mu.Unlock()
+
+	return &http.Response{
+		StatusCode: 200,
+		Header:     http.Header{},
+		Body:       io.NopCloser(bytes.NewReader([]byte(`{"id":"12_3","userName":"admin"}`))),
+	}, nil
+}
+
+func newTestClient(httpClient HTTPClient, optFns ...func(*Options)) *Client {
+	return New(Options{
+		Subdomain:  "example",
+		HTTPClient: httpClient,
+		Credentials: cybr.CredentialsProviderFunc(func(context.Context) (cybr.Credentials, error) {
+			return cybr.Credentials{SessionToken: "token"}, nil
+		}),
+	}, optFns...)
+}
+
+func countStackBuilds(count *int32) func(*Options) {
+	return WithAPIOptions(func(*middleware.Stack) error {
+		atomic.AddInt32(count, 1)
+		return nil
+	})
+}
+
+func TestClient_ReusesOperationStack(t *testing.T) {
+	var builds int32
+	client := newTestClient(&stubHTTPClient{}, countStackBuilds(&builds))
+
+	for i := 0; i < 3; i++ {
+		out, err := client.GetAccount(context.Background(), &GetAccountInput{AccountId: cybr.String("12_3")})
+		if err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+		if e, a := "admin", cybr.ToString(out.UserName); e != a {
+			t.Errorf("expect %v user name, got %v", e, a)
+		}
+	}
+	if e, a := int32(1), atomic.LoadInt32(&builds); e != a {
+		t.Errorf("expect the stack to be built %v times, got %v", e, a)
+	}
+
+	// Each operation has its own stack.
+	if _, err := client.DeleteAccount(context.Background(), &DeleteAccountInput{AccountId: cybr.String("12_3")}); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := int32(2), atomic.LoadInt32(&builds); e != a {
+		t.Errorf("expect the stack to be built %v times, got %v", e, a)
+	}
+}
+
+func TestClient_PerCallOptionsNotCached(t *testing.T) {
+	var builds int32
+	httpClient := &stubHTTPClient{}
+	client := newTestClient(httpClient, countStackBuilds(&builds))
+
+	withHeader := WithAPIOptions(func(stack *middleware.Stack) error {
+		return stack.Build.Add(middleware.BuildMiddlewareFunc("TestHeader", func(
+			ctx context.Context, in middleware.BuildInput, next middleware.BuildHandler,
+		) (middleware.BuildOutput, middleware.Metadata, error) {
+			in.Request.(*smithyhttp.Request).Header.Set("X-Test", "per-call")
+			return next.HandleBuild(ctx, in)
+		}), middleware.After)
+	})
+
+	input := &GetAccountInput{AccountId: cybr.String("12_3")}
+	for _, optFns := range [][]func(*Options){nil, {withHeader}, nil, {withHeader}} {
+		if _, err := client.GetAccount(context.Background(), input, optFns...); err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+	}
+
+	if e, a := int32(3), atomic.LoadInt32(&builds); e != a {
+		t.Errorf("expect the stack to be built %v times, got %v", e, a)
+	}
+	for i, expect := range []string{"", "per-call", "", "per-call"} {
+		if a := httpClient.headers[i].Get("X-Test"); expect != a {
+			t.Errorf("expect request %d header %q, got %q", i, expect, a)
+		}
+	}
+}
+
+func TestClient_UserAgent(t *testing.T) {
+	httpClient := &stubHTTPClient{}
+	client := newTestClient(httpClient, func(o *Options) {
+		o.AppID = "my-tool"
+	})
+
+	if _, err := client.GetAccount(context.Background(), &GetAccountInput{AccountId: cybr.String("12_3")}); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	ua := httpClient.headers[0].Get("User-Agent")
+	for _, expect := range []string{
+		cybr.SDKName + "/" + cybr.SDKVersion,
+		"api/privilege-cloud#GetAccount",
+		"app/my-tool",
+	} {
+		if !strings.Contains(ua, expect) {
+			t.Errorf("expect User-Agent %q to contain %q", ua, expect)
+		}
+	}
+}
+
+func TestClient_RetrievePassword(t *testing.T) {
+	cases := map[string]struct {
+		StatusCode     int
+		Body           string
+		ExpectPassword string
+		ExpectErr      interface{}
+	}{
+		"password": {
+			StatusCode:     200,
+			Body:           `"Secret1!"`,
+			ExpectPassword: "Secret1!",
+		},
+		"requires reason": {
+			StatusCode: 403,
+			Body:       `{"ErrorCode":"ITATS542I","ErrorMessage":"reason is required"}`,
+			ExpectErr:  &types.RequiresReasonException{},
+		},
+		"requires confirmation": {
+			StatusCode: 403,
+			Body:       `{"ErrorCode":"ITATS543I","ErrorMessage":"dual control"}`,
+			ExpectErr:  &types.RequiresConfirmationException{},
+		},
+		"status code error": {
+			StatusCode: 403,
+			Body:       `{"ErrorCode":"PASWS013E","ErrorMessage":"forbidden"}`,
+			ExpectErr:  &types.ForbiddenException{},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := newTestClient(smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: c.StatusCode,
+					Header:     http.Header{},
+					Body:       io.NopCloser(strings.NewReader(c.Body)),
+				}, nil
+			}))
+
+			out, err := client.RetrievePassword(context.Background(), &RetrievePasswordInput{
+				AccountId: cybr.String("12_3"),
+				Reason:    cybr.String("maintenance"),
+			})
+			if c.ExpectErr != nil {
+				if err == nil {
+					t.Fatalf("expect error, got none")
+				}
+				target := reflect.New(reflect.TypeOf(c.ExpectErr)).Interface()
+				if !errors.As(err, target) {
+					t.Errorf("expect %T error, got %v", c.ExpectErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.ExpectPassword, cybr.ToString(out.Password); e != a {
+				t.Errorf("expect password %v, got %v", e, a)
+			}
+		})
+	}
+}
+
+// logBuffer returns a functional option logging the requests and responses
+// of the client with their bodies to the buffer.
+func logBuffer(buf *bytes.Buffer) func(*Options) {
+	var mu sync.Mutex
+	return func(o *Options) {
+		o.ClientLogMode = cybr.LogRequestWithBody | cybr.LogResponseWithBody
+		o.Logger = logging.LoggerFunc(func(_ logging.Classification, format string, v ...interface{}) {
+			mu.Lock()
+			defer mu.Unlock()
+			fmt.Fprintf(buf, format+"\n", v...)
+		})
+	}
+}
+
+func TestClient_RetrievePasswordNotLogged(t *testing.T) {
+	var buf bytes.Buffer
+	client := newTestClient(smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: 200,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(`"Secret1!"`)),
+		}, nil
+	}), logBuffer(&buf))
+
+	out, err := client.RetrievePassword(context.Background(), &RetrievePasswordInput{
+		AccountId: cybr.String("12_3"),
+		Reason:    cybr.String("maintenance"),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "Secret1!", cybr.ToString(out.Password); e != a {
+		t.Errorf("expect password %v, got %v", e, a)
+	}
+
+	log := buf.String()
+	if strings.Contains(log, "Secret1!") {
+		t.Errorf("expect password not to be logged, got\n%s", log)
+	}
+	for _, expect := range []string{"maintenance", "200 OK"} {
+		if !strings.Contains(log, expect) {
+			t.Errorf("expect %q to be logged, got\n%s", expect, log)
+		}
+	}
+}
+
+func TestClient_IncomingRequests(t *testing.T) {
+	var requests []*http.Request
+	var bodies []string
+	client := newTestClient(smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+		requests = append(requests, r)
+		var body []byte
+		if r.Body != nil {
+			body, _ = io.ReadAll(r.Body)
+		}
+		bodies = append(bodies, string(body))
+
+		respBody := ""
+		if r.Method == http.MethodGet {
+			respBody = `{"IncomingRequests":[{"RequestID":"1_5","AccountId":"12_3",` +
+				`"AccessType":"Connect","MultipleAccessRequired":true,` +
+				`"AccessFrom":1700000000,"AccessTo":1700003600,"StatusTitle":"Waiting"}]}`
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(respBody)),
+		}, nil
+	}))
+
+	list, err := client.ListIncomingRequests(context.Background(), &ListIncomingRequestsInput{
+		OnlyWaiting: cybr.Bool(true),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := 1, len(list.IncomingRequests); e != a {
+		t.Fatalf("expect %v requests, got %v", e, a)
+	}
+	req := list.IncomingRequests[0]
+	if e, a := "1_5", cybr.ToString(req.RequestId); e != a {
+		t.Errorf("expect request ID %v, got %v", e, a)
+	}
+	if e, a := types.AccessTypeConnect, req.AccessType; e != a {
+		t.Errorf("expect access type %v, got %v", e, a)
+	}
+	if e, a := types.AccessRequestStatusWaiting, req.StatusTitle; e != a {
+		t.Errorf("expect status %v, got %v", e, a)
+	}
+	if !cybr.ToBool(req.MultipleAccessRequired) {
+		t.Errorf("expect multiple access required")
+	}
+	if e, a := int64(3600), req.AccessTo.Unix()-req.AccessFrom.Unix(); e != a {
+		t.Errorf("expect timeframe of %vs, got %vs", e, a)
+	}
+
+	_, err = client.RejectRequest(context.Background(), &RejectRequestInput{
+		RequestId: req.RequestId,
+		Reason:    cybr.String("not in a change window"),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := "/PasswordVault/API/IncomingRequests", requests[0].URL.Path; e != a {
+		t.Errorf("expect list path %v, got %v", e, a)
+	}
+	if e, a := "true", requests[0].URL.Query().Get("onlywaiting"); e != a {
+		t.Errorf("expect onlywaiting %v, got %v", e, a)
+	}
+	if e, a := "/PasswordVault/API/IncomingRequests/1_5/Reject", requests[1].URL.Path; e != a {
+		t.Errorf("expect reject path %v, got %v", e, a)
+	}
+	if e, a := `{"Reason":"not in a change window"}`, bodies[1]; e != a {
+		t.Errorf("expect reject body %v, got %v", e, a)
+	}
+
+	_, err = client.RejectRequest(context.Background(), &RejectRequestInput{RequestId: req.RequestId})
+	if err == nil {
+		t.Fatalf("expect reason required error, got none")
+	}
+}
+
+func TestClient_SafeLifecycle(t *testing.T) {
+	cases := map[string]struct {
+		Call       func(*Client) error
+		StatusCode int
+		Body       string
+		ExpectPath string
+		ExpectBody string
+		ExpectErr  interface{}
+	}{
+		"create": {
+			Call: func(c *Client) error {
+				_, err := c.CreateSafe(context.Background(), &CreateSafeInput{
+					SafeName:              cybr.String("Operations"),
+					Description:           cybr.String("Operations accounts"),
+					ManagingCPM:           cybr.String("PasswordManager"),
+					NumberOfDaysRetention: cybr.Int32(7),
+					OlacEnabled:           cybr.Bool(true),
+				})
+				return err
+			},
+			StatusCode: 201,
+			Body:       `{"safeUrlId":"Operations","safeName":"Operations"}`,
+			ExpectPath: "/PasswordVault/API/Safes",
+			ExpectBody: `{"safeName":"Operations","description":"Operations accounts",` +
+				`"managingCPM":"PasswordManager","numberOfDaysRetention":7,"olacEnabled":true}`,
+		},
+		"create conflict": {
+			Call: func(c *Client) error {
+				_, err := c.CreateSafe(context.Background(), &CreateSafeInput{SafeName: cybr.String("Operations")})
+				return err
+			},
+			StatusCode: 409,
+			Body:       `{"ErrorCode":"SFWS0002E","ErrorMessage":"Safe Operations already exists."}`,
+			ExpectErr:  &types.SafeAlreadyExistsException{},
+		},
+		"update not found": {
+			Call: func(c *Client) error {
+				_, err := c.UpdateSafe(context.Background(), &UpdateSafeInput{
+					SafeUrlId:   cybr.String("Operations"),
+					ManagingCPM: cybr.String("PasswordManager1"),
+				})
+				return err
+			},
+			StatusCode: 404,
+			Body:       `{"ErrorCode":"SFWS0007E","ErrorMessage":"Safe Operations was not found."}`,
+			ExpectErr:  &types.SafeNotFoundException{},
+		},
+		"delete retention period": {
+			Call: func(c *Client) error {
+				_, err := c.DeleteSafe(context.Background(), &DeleteSafeInput{SafeUrlId: cybr.String("Operations")})
+				return err
+			},
+			StatusCode: 409,
+			Body:       `{"ErrorCode":"SFWS0010E","ErrorMessage":"Safe Operations contains retained versions."}`,
+			ExpectErr:  &types.SafeRetentionPeriodException{},
+		},
+		"delete conflict": {
+			Call: func(c *Client) error {
+				_, err := c.DeleteSafe(context.Background(), &DeleteSafeInput{SafeUrlId: cybr.String("Operations")})
+				return err
+			},
+			StatusCode: 409,
+			Body:       `{"ErrorCode":"SFWS0015E","ErrorMessage":"Safe Operations is in use."}`,
+			ExpectErr:  &types.ConflictException{},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var req *http.Request
+			var body []byte
+			client := newTestClient(smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+				req = r
+				if r.Body != nil {
+					body, _ = io.ReadAll(r.Body)
+				}
+				return &http.Response{
+					StatusCode: c.StatusCode,
+					Header:     http.Header{},
+					Body:       io.NopCloser(strings.NewReader(c.Body)),
+				}, nil
+			}))
+
+			err := c.Call(client)
+			if c.ExpectErr != nil {
+				if err == nil {
+					t.Fatalf("expect error, got none")
+				}
+				target := reflect.New(reflect.TypeOf(c.ExpectErr)).Interface()
+				if !errors.As(err, target) {
+					t.Errorf("expect %T error, got %v", c.ExpectErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.ExpectPath, req.URL.Path; e != a {
+				t.Errorf("expect path %v, got %v", e, a)
+			}
+			if e, a := c.ExpectBody, string(body); e != a {
+				t.Errorf("expect body %v, got %v", e, a)
+			}
+		})
+	}
+}
+
+type closeRecorder struct {
+	io.Reader
+	closed bool
+}
+
+func (r *closeRecorder) Close() error {
+	r.closed = true
+	return nil
+}
+
+func TestClient_ExportPlatform(t *testing.T) {
+	pkg := &closeRecorder{Reader: strings.NewReader("PK\x03\x04")}
+	client := newTestClient(smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+		if e, a := "/PasswordVault/API/Platforms/WinDomain/Export", r.URL.Path; e != a {
+			t.Errorf("expect path %v, got %v", e, a)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Header:     http.Header{"Content-Type": []string{"application/octet-stream"}},
+			Body:       pkg,
+		}, nil
+	}))
+
+	out, err := client.ExportPlatform(context.Background(), &ExportPlatformInput{
+		PlatformId: cybr.String("WinDomain"),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if pkg.closed {
+		t.Fatalf("expect body not to be closed before it is read")
+	}
+
+	b, err := io.ReadAll(out.Body)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "PK\x03\x04", string(b); e != a {
+		t.Errorf("expect body %q, got %q", e, a)
+	}
+	out.Body.Close()
+	if !pkg.closed {
+		t.Errorf("expect body to be closed by the caller")
+	}
+}
+
+func TestClient_ImportPlatform(t *testing.T) {
+	pkg := bytes.Repeat([]byte("PK\x03\x04"), 64*1024)
+
+	var contentLength int64
+	var body struct{ ImportFile []byte }
+	client := newTestClient(smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+		contentLength = r.ContentLength
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("expect JSON request body, got %v", err)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(`{"PlatformID":"WinDomainCustom"}`)),
+		}, nil
+	}), func(o *Options) {
+		o.ClientLogMode = cybr.LogRequestWithBody | cybr.LogResponseWithBody
+	})
+
+	// the package is not seekable, and is streamed rather than buffered to
+	// compute the content length, or to log the request body.
+	out, err := client.ImportPlatform(context.Background(), &ImportPlatformInput{
+		ImportFile: io.MultiReader(bytes.NewReader(pkg)),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "WinDomainCustom", cybr.ToString(out.PlatformId); e != a {
+		t.Errorf("expect platform ID %v, got %v", e, a)
+	}
+	if e, a := int64(-1), contentLength; e != a {
+		t.Errorf("expect content length %v, got %v", e, a)
+	}
+	if !bytes.Equal(pkg, body.ImportFile) {
+		t.Errorf("expect the imported package to match")
+	}
+}
+
+func TestClient_Recordings(t *testing.T) {
+	pages := []string{
+		`{"Recordings":[{"SessionID":"1","User":"jdoe","SafeName":"Operations","Start":1700000000,` +
+			`"RecordingFiles":[{"FileName":"1.avi","RecordingType":"Video","FileSize":1024}]},` +
+			`{"SessionID":"2","User":"jdoe","SafeName":"Operations","Start":1700000100}],"Total":3}`,
+		`{"Recordings":[{"SessionID":"3","User":"jdoe","SafeName":"Operations","Start":1700000200}],"Total":3}`,
+	}
+
+	var queries []url.Values
+	client := newTestClient(smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+		if r.URL.Path == "/PasswordVault/API/Recordings/1/Play" {
+			return &http.Response{
+				StatusCode: 200,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader("RIFF")),
+			}, nil
+		}
+		queries = append(queries, r.URL.Query())
+		return &http.Response{
+			StatusCode: 200,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(pages[len(queries)-1])),
+		}, nil
+	}))
+
+	p := NewListRecordingsPaginator(client, &ListRecordingsInput{
+		Safe:     cybr.String("Operations"),
+		Search:   cybr.String("jdoe"),
+		FromTime: cybr.Time(time.Unix(1700000000, 0)),
+		ToTime:   cybr.Time(time.Unix(1700086400, 0)),
+	}, func(o *ListRecordingsPaginatorOptions) {
+		o.Limit = 2
+	})
+
+	var ids []string
+	for p.HasMorePages() {
+		page, err := p.NextPage(context.Background())
+		if err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+		for _, r := range page.Recordings {
+			ids = append(ids, cybr.ToString(r.SessionId))
+		}
+	}
+	if e, a := []string{"1", "2", "3"}, ids; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect recordings %v, got %v", e, a)
+	}
+	if e, a := 2, len(queries); e != a {
+		t.Fatalf("expect %v requests, got %v", e, a)
+	}
+	for k, v := range map[string]string{
+		"Safe":     "Operations",
+		"Search":   "jdoe",
+		"FromTime": "1700000000",
+		"ToTime":   "1700086400",
+		"Limit":    "2",
+	} {
+		if e, a := v, queries[1].Get(k); e != a {
+			t.Errorf("expect %v query %v, got %v", k, e, a)
+		}
+	}
+	if e, a := "2", queries[1].Get("Offset"); e != a {
+		t.Errorf("expect offset %v, got %v", e, a)
+	}
+
+	out, err := client.DownloadRecording(context.Background(), &DownloadRecordingInput{
+		RecordingId: cybr.String("1"),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	defer out.Body.Close()
+	b, err := io.ReadAll(out.Body)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "RIFF", string(b); e != a {
+		t.Errorf("expect recording %q, got %q", e, a)
+	}
+}
+
+func TestClient_ConcurrentCalls(t *testing.T) {
+	client := newTestClient(&stubHTTPClient{})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				if _, err := client.GetAccount(context.Background(), &GetAccountInput{AccountId: cybr.String("12_3")}); err != nil {
+					t.Errorf("expect no error, got %v", err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// accountIDKey is the stack value key of the account ID of the call.
+type accountIDKey struct{}
+
+// countingAPIOption returns an APIOption adding stateful middleware, which
+// counts the requests sent and keeps the account ID of each call in the
+// context.
+func countingAPIOption(runs, requests *int32) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		atomic.AddInt32(runs, 1)
+		if err := stack.Initialize.Add(middleware.InitializeMiddlewareFunc("SetAccountID", func(
+			ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler,
+		) (middleware.InitializeOutput, middleware.Metadata, error) {
+			ctx = middleware.WithStackValue(ctx, accountIDKey{}, *in.Parameters.(*GetAccountInput).AccountId)
+			return next.HandleInitialize(ctx, in)
+		}), middleware.After); err != nil {
+			return err
+		}
+		return stack.Build.Add(middleware.BuildMiddlewareFunc("CountRequests", func(
+			ctx context.Context, in middleware.BuildInput, next middleware.BuildHandler,
+		) (middleware.BuildOutput, middleware.Metadata, error) {
+			atomic.AddInt32(requests, 1)
+			req := in.Request.(*smithyhttp.Request)
+			req.Header.Set("X-Account-Id", middleware.GetStackValue(ctx, accountIDKey{}).(string))
+			return next.HandleBuild(ctx, in)
+		}), middleware.After)
+	}
+}
+
+func TestClient_ConcurrentCallsStatefulAPIOption(t *testing.T) {
+	var runs, requests int32
+	client := newTestClient(smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+		if e, a := "/"+r.Header.Get("X-Account-Id"), r.URL.Path; !strings.HasSuffix(a, e) {
+			t.Errorf("expect %v account ID header for %v request", e, a)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(`{"id":"12_3"}`)),
+		}, nil
+	}), WithAPIOptions(countingAPIOption(&runs, &requests)))
+
+	if _, err := client.GetAccount(context.Background(), &GetAccountInput{AccountId: cybr.String("12_3")}); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	const goroutines, calls = 16, 10
+	var wg sync.WaitGroup
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < calls; j++ {
+				input := &GetAccountInput{AccountId: cybr.String(fmt.Sprintf("%d_%d", i, j))}
+				if _, err := client.GetAccount(context.Background(), input); err != nil {
+					t.Errorf("expect no error, got %v", err)
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if e, a := int32(1), atomic.LoadInt32(&runs); e != a {
+		t.Errorf("expect APIOptions run %v times, got %v", e, a)
+	}
+	if e, a := int32(goroutines*calls+1), atomic.LoadInt32(&requests); e != a {
+		t.Errorf("expect %v requests counted, got %v", e, a)
+	}
+}
+
+func BenchmarkGetAccount(b *testing.B) {
+	input := &GetAccountInput{AccountId: cybr.String("12_3")}
+
+	b.Run("cached stack", func(b *testing.B) {
+		client := newTestClient(&benchmarkHTTPClient{})
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			if _, err := client.GetAccount(context.Background(), input); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("per-call options", func(b *testing.B) {
+		client := newTestClient(&benchmarkHTTPClient{})
+		noop := func(*Options) {}
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			if _, err := client.GetAccount(context.Background(), input, noop); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// benchmarkHTTPClient returns a response without recording the requests.
+type benchmarkHTTPClient struct{}
+
+func (benchmarkHTTPClient) Do(*http.Request) (*http.Response, error) {
+	return &http.Response{
+		StatusCode: 200,
+		Header:     http.Header{},
+		Body:       io.NopCloser(bytes.NewReader([]byte(`{"id":"12_3","userName":"admin"}`))),
+	}, nil
+}
+
+func TestClient_ClockSkewCredentialsExpiry(t *testing.T) {
+	cybr.ResetClockSkews()
+	defer cybr.ResetClockSkews()
+
+	var host string
+	client := newTestClient(smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+		host = r.URL.Host
+		return &http.Response{
+			StatusCode: 200,
+			Header: http.Header{
+				"Date": []string{time.Now().Add(10 * time.Minute).UTC().Format(http.TimeFormat)},
+			},
+			Body: io.NopCloser(strings.NewReader(`{"id":"12_3"}`)),
+		}, nil
+	}))
+	if _, err := client.GetAccount(context.Background(), &GetAccountInput{AccountId: cybr.String("12_3")}); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	cases := map[string]struct {
+		host   string
+		expect bool
+	}{
+		"issuing host skewed": {host: host, expect: true},
+		"other host":          {host: "other.example.com"},
+		"no host":             {},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			provider := cybr.NewCredentialsCache(credentials.StaticCredentialsProvider{
+				Value: cybr.Credentials{
+					Username:     "username",
+					Password:     "password",
+					SessionToken: "token",
+					CanExpire:    true,
+					Expires:      time.Now().Add(5 * time.Minute),
+					Host:         c.host,
+				},
+			})
+
+			creds, err := provider.Retrieve(context.Background())
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.expect, creds.Expired(); e != a {
+				t.Errorf("expect expired %v, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestClient_ClockSkewCredentialsRefresh(t *testing.T) {
+	cases := map[string]struct {
+		skew            time.Duration
+		expectRetrieved int
+	}{
+		"server clock ahead":  {skew: 10 * time.Minute, expectRetrieved: 2},
+		"server clock behind": {skew: -10 * time.Minute, expectRetrieved: 1},
+		"no skew":             {expectRetrieved: 1},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			cybr.ResetClockSkews()
+			defer cybr.ResetClockSkews()
+
+			var retrieved int
+			provider := cybr.NewCredentialsCache(cybr.CredentialsProviderFunc(func(context.Context) (cybr.Credentials, error) {
+				retrieved++
+				return cybr.Credentials{
+					SessionToken: "token",
+					CanExpire:    true,
+					Expires:      time.Now().Add(5 * time.Minute),
+				}, nil
+			}))
+
+			client := New(Options{
+				Subdomain:   "example",
+				Credentials: provider,
+				HTTPClient: smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+					return &http.Response{
+						StatusCode: 200,
+						Header: http.Header{
+							"Date": []string{time.Now().Add(c.skew).UTC().Format(http.TimeFormat)},
+						},
+						Body: io.NopCloser(strings.NewReader(`{"id":"12_3"}`)),
+					}, nil
+				}),
+			})
+
+			for i := 0; i < 2; i++ {
+				if _, err := client.GetAccount(context.Background(), &GetAccountInput{AccountId: cybr.String("12_3")}); err != nil {
+					t.Fatalf("expect no error, got %v", err)
+				}
+			}
+
+			if e, a := c.expectRetrieved, retrieved; e != a {
+				t.Errorf("expect credentials retrieved %v times, got %v", e, a)
+			}
+		})
+	}
+}
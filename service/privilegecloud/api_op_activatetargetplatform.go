@@ -0,0 +1,117 @@
+// Code generated by internal/codegen DO NOT EDIT.
+
+package privilegecloud
+
+import (
+	"context"
+	"fmt"
+
+	cybrmiddleware "github.com/strick-j/cybr-sdk-alpha/cybr/middleware"
+	"github.com/strick-j/smithy-go/middleware"
+	smithyhttp "github.com/strick-j/smithy-go/transport/http"
+)
+
+// Activates a target platform, so accounts can be added with it.
+func (c *Client) ActivateTargetPlatform(ctx context.Context, params *ActivateTargetPlatformInput, optFns ...func(*Options)) (*ActivateTargetPlatformOutput, error) {
+	if params == nil {
+		params = &ActivateTargetPlatformInput{}
+	}
+
+	result, metadata, err := c.invokeOperation(ctx, "ActivateTargetPlatform", params, optFns, c.addOperationActivateTargetPlatformMiddlewares)
+	if err != nil {
+		return nil, err
+	}
+
+	out := result.(*ActivateTargetPlatformOutput)
+	out.ResultMetadata = metadata
+	return out, nil
+}
+
+type ActivateTargetPlatformInput struct {
+	// The unique numeric ID of the target platform.
+	//
+	// This member is required.
+	Id *int32
+}
+
+type ActivateTargetPlatformOutput struct {
+	// Metadata pertaining to the operation's result.
+	ResultMetadata middleware.Metadata
+}
+
+func (c *Client) addOperationActivateTargetPlatformMiddlewares(stack *middleware.Stack, options Options) (err error) {
+	if err := stack.Serialize.Add(&setOperationInputMiddleware{}, middleware.After); err != nil {
+		return err
+	}
+	err = stack.Serialize.Add(&cybrRestjson_serializeOpActivateTargetPlatform{}, middleware.After)
+	if err != nil {
+		return err
+	}
+	err = stack.Deserialize.Add(&cybrRestjson_deserializeOpActivateTargetPlatform{}, middleware.After)
+	if err != nil {
+		return err
+	}
+	if err := addProtocolFinalizerMiddlewares(stack, options, "ActivateTargetPlatform"); err != nil {
+		return fmt.Errorf("add protocol finalizers: %v", err)
+	}
+
+	if err = addSetLoggerMiddleware(stack, options); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddClientRequestIDMiddleware(stack); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddRequestAttemptMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addUserAgentMiddleware(stack, options); err != nil {
+		return err
+	}
+	if err = smithyhttp.AddComputeContentLengthMiddleware(stack); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddRawResponseToMetadata(stack); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddRecordResponseTiming(stack); err != nil {
+		return err
+	}
+	if err = smithyhttp.AddErrorCloseResponseBodyMiddleware(stack); err != nil {
+		return err
+	}
+	if err = smithyhttp.AddCloseResponseBodyMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addOpActivateTargetPlatformValidationMiddleware(stack); err != nil {
+		return err
+	}
+	if err = stack.Initialize.Add(newServiceMetadataMiddleware_opActivateTargetPlatform(options.Subdomain, options.Domain), middleware.Before); err != nil {
+		return err
+	}
+	if err = addRequestIDRetrieverMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addResponseErrorMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addRequestResponseLogging(stack, options); err != nil {
+		return err
+	}
+	if err = addRateLimitMiddleware(stack, options); err != nil {
+		return err
+	}
+	if err = addTracingMiddleware(stack, options); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func newServiceMetadataMiddleware_opActivateTargetPlatform(subdomain string, domain string) *cybrmiddleware.RegisterServiceMetadata {
+	return &cybrmiddleware.RegisterServiceMetadata{
+		Subdomain:     subdomain,
+		Domain:        domain,
+		ServiceID:     ServiceID,
+		OperationName: "ActivateTargetPlatform",
+	}
+}
@@ -0,0 +1,185 @@
+// Code generated by internal/codegen DO NOT EDIT.
+
+package privilegecloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cybrmiddleware "github.com/strick-j/cybr-sdk-alpha/cybr/middleware"
+	"github.com/strick-j/cybr-sdk-alpha/service/privilegecloud/types"
+	"github.com/strick-j/smithy-go/middleware"
+	smithyhttp "github.com/strick-j/smithy-go/transport/http"
+)
+
+// Adds a new privileged account to a safe.
+func (c *Client) AddAccount(ctx context.Context, params *AddAccountInput, optFns ...func(*Options)) (*AddAccountOutput, error) {
+	if params == nil {
+		params = &AddAccountInput{}
+	}
+
+	result, metadata, err := c.invokeOperation(ctx, "AddAccount", params, optFns, c.addOperationAddAccountMiddlewares)
+	if err != nil {
+		return nil, err
+	}
+
+	out := result.(*AddAccountOutput)
+	out.ResultMetadata = metadata
+	return out, nil
+}
+
+type AddAccountInput struct {
+	// The ID of the platform the account is managed by.
+	//
+	// This member is required.
+	PlatformId *string
+
+	// The name of the safe the account is stored in.
+	//
+	// This member is required.
+	SafeName *string
+
+	// The address of the machine the account is used on.
+	Address *string
+
+	// The name of the account. Generated from the address, user name and platform
+	// if not set.
+	Name *string
+
+	// The platform specific properties of the account.
+	PlatformAccountProperties map[string]string
+
+	// The machines an account can be used to connect to.
+	RemoteMachinesAccess *types.RemoteMachinesAccess
+
+	// The password or SSH key of the account.
+	Secret *string
+
+	// The way the secret of an account is managed by the CPM.
+	SecretManagement *types.SecretManagement
+
+	// The type of the secret of an account.
+	SecretType types.SecretType
+
+	// The user name of the account.
+	UserName *string
+}
+
+type AddAccountOutput struct {
+	// The address of the machine the account is used on.
+	Address *string
+
+	// The time the properties of the account were last modified.
+	CategoryModificationTime *time.Time
+
+	// The time the account was created.
+	CreatedTime *time.Time
+
+	// The unique ID of the account.
+	Id *string
+
+	// The name of the account.
+	Name *string
+
+	// The platform specific properties of the account.
+	PlatformAccountProperties map[string]string
+
+	// The ID of the platform the account is managed by.
+	PlatformId *string
+
+	// The machines an account can be used to connect to.
+	RemoteMachinesAccess *types.RemoteMachinesAccess
+
+	// The name of the safe the account is stored in.
+	SafeName *string
+
+	// The way the secret of an account is managed by the CPM.
+	SecretManagement *types.SecretManagement
+
+	// The type of the secret of an account.
+	SecretType types.SecretType
+
+	// The user name of the account.
+	UserName *string
+
+	// Metadata pertaining to the operation's result.
+	ResultMetadata middleware.Metadata
+}
+
+func (c *Client) addOperationAddAccountMiddlewares(stack *middleware.Stack, options Options) (err error) {
+	if err := stack.Serialize.Add(&setOperationInputMiddleware{}, middleware.After); err != nil {
+		return err
+	}
+	err = stack.Serialize.Add(&cybrRestjson_serializeOpAddAccount{}, middleware.After)
+	if err != nil {
+		return err
+	}
+	err = stack.Deserialize.Add(&cybrRestjson_deserializeOpAddAccount{}, middleware.After)
+	if err != nil {
+		return err
+	}
+	if err := addProtocolFinalizerMiddlewares(stack, options, "AddAccount"); err != nil {
+		return fmt.Errorf("add protocol finalizers: %v", err)
+	}
+
+	if err = addSetLoggerMiddleware(stack, options); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddClientRequestIDMiddleware(stack); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddRequestAttemptMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addUserAgentMiddleware(stack, options); err != nil {
+		return err
+	}
+	if err = smithyhttp.AddComputeContentLengthMiddleware(stack); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddRawResponseToMetadata(stack); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddRecordResponseTiming(stack); err != nil {
+		return err
+	}
+	if err = smithyhttp.AddErrorCloseResponseBodyMiddleware(stack); err != nil {
+		return err
+	}
+	if err = smithyhttp.AddCloseResponseBodyMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addOpAddAccountValidationMiddleware(stack); err != nil {
+		return err
+	}
+	if err = stack.Initialize.Add(newServiceMetadataMiddleware_opAddAccount(options.Subdomain, options.Domain), middleware.Before); err != nil {
+		return err
+	}
+	if err = addRequestIDRetrieverMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addResponseErrorMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addRequestResponseLogging(stack, options); err != nil {
+		return err
+	}
+	if err = addRateLimitMiddleware(stack, options); err != nil {
+		return err
+	}
+	if err = addTracingMiddleware(stack, options); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func newServiceMetadataMiddleware_opAddAccount(subdomain string, domain string) *cybrmiddleware.RegisterServiceMetadata {
+	return &cybrmiddleware.RegisterServiceMetadata{
+		Subdomain:     subdomain,
+		Domain:        domain,
+		ServiceID:     ServiceID,
+		OperationName: "AddAccount",
+	}
+}
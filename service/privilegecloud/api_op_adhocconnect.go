@@ -0,0 +1,161 @@
+// Code generated by internal/codegen DO NOT EDIT.
+
+package privilegecloud
+
+import (
+	"context"
+	"fmt"
+
+	cybrmiddleware "github.com/strick-j/cybr-sdk-alpha/cybr/middleware"
+	"github.com/strick-j/cybr-sdk-alpha/service/privilegecloud/types"
+	"github.com/strick-j/smithy-go/middleware"
+	smithyhttp "github.com/strick-j/smithy-go/transport/http"
+)
+
+// Connects to a target through PSM with credentials that are not stored in the
+// vault, returning the RDP file of the connection, or the connection token of
+// the PSM HTML5 gateway. See WriteRDPFile to save the RDP file.
+func (c *Client) AdHocConnect(ctx context.Context, params *AdHocConnectInput, optFns ...func(*Options)) (*AdHocConnectOutput, error) {
+	if params == nil {
+		params = &AdHocConnectInput{}
+	}
+
+	result, metadata, err := c.invokeOperation(ctx, "AdHocConnect", params, optFns, c.addOperationAdHocConnectMiddlewares)
+	if err != nil {
+		return nil, err
+	}
+
+	out := result.(*AdHocConnectOutput)
+	out.ResultMetadata = metadata
+	return out, nil
+}
+
+type AdHocConnectInput struct {
+	// The address of the target.
+	//
+	// This member is required.
+	Address *string
+
+	// The connection component and the authorization of an ad hoc connection.
+	//
+	// This member is required.
+	PSMConnectPrerequisites *types.ConnectPrerequisites
+
+	// The ID of the platform whose connection components and policy apply to the
+	// connection.
+	//
+	// This member is required.
+	PlatformId *string
+
+	// The password or SSH key of the credentials the target is connected with.
+	//
+	// This member is required.
+	Secret *string
+
+	// The user name of the credentials the target is connected with.
+	//
+	// This member is required.
+	UserName *string
+
+	// The parameters of the connection, overriding the defaults of the connection
+	// component.
+	ConnectionParams *types.ConnectionParameters
+
+	// Additional properties of the connection, e.g. the logon domain, by property
+	// name.
+	ExtraFields map[string]string
+}
+
+type AdHocConnectOutput struct {
+	// The connection token posted to the HTML5 gateway to open the session.
+	PSMGWRequest *string
+
+	// The URL of the PSM HTML5 gateway the session is opened at.
+	PSMGWURL *string
+
+	// The contents of the RDP file of the connection, opened with a Remote Desktop
+	// client.
+	//
+	// Set if the response body is not JSON.
+	RDPFile []byte
+
+	// Metadata pertaining to the operation's result.
+	ResultMetadata middleware.Metadata
+}
+
+func (c *Client) addOperationAdHocConnectMiddlewares(stack *middleware.Stack, options Options) (err error) {
+	if err := stack.Serialize.Add(&setOperationInputMiddleware{}, middleware.After); err != nil {
+		return err
+	}
+	err = stack.Serialize.Add(&cybrRestjson_serializeOpAdHocConnect{}, middleware.After)
+	if err != nil {
+		return err
+	}
+	err = stack.Deserialize.Add(&cybrRestjson_deserializeOpAdHocConnect{}, middleware.After)
+	if err != nil {
+		return err
+	}
+	if err := addProtocolFinalizerMiddlewares(stack, options, "AdHocConnect"); err != nil {
+		return fmt.Errorf("add protocol finalizers: %v", err)
+	}
+
+	if err = addSetLoggerMiddleware(stack, options); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddClientRequestIDMiddleware(stack); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddRequestAttemptMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addUserAgentMiddleware(stack, options); err != nil {
+		return err
+	}
+	if err = smithyhttp.AddComputeContentLengthMiddleware(stack); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddRawResponseToMetadata(stack); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddRecordResponseTiming(stack); err != nil {
+		return err
+	}
+	if err = smithyhttp.AddErrorCloseResponseBodyMiddleware(stack); err != nil {
+		return err
+	}
+	if err = smithyhttp.AddCloseResponseBodyMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addOpAdHocConnectValidationMiddleware(stack); err != nil {
+		return err
+	}
+	if err = stack.Initialize.Add(newServiceMetadataMiddleware_opAdHocConnect(options.Subdomain, options.Domain), middleware.Before); err != nil {
+		return err
+	}
+	if err = addRequestIDRetrieverMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addResponseErrorMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addStreamingRequestResponseLogging(stack, options); err != nil {
+		return err
+	}
+	if err = addRateLimitMiddleware(stack, options); err != nil {
+		return err
+	}
+	if err = addTracingMiddleware(stack, options); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func newServiceMetadataMiddleware_opAdHocConnect(subdomain string, domain string) *cybrmiddleware.RegisterServiceMetadata {
+	return &cybrmiddleware.RegisterServiceMetadata{
+		Subdomain:     subdomain,
+		Domain:        domain,
+		ServiceID:     ServiceID,
+		OperationName: "AdHocConnect",
+	}
+}
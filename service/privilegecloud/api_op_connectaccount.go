@@ -0,0 +1,155 @@
+// Code generated by internal/codegen DO NOT EDIT.
+
+package privilegecloud
+
+import (
+	"context"
+	"fmt"
+
+	cybrmiddleware "github.com/strick-j/cybr-sdk-alpha/cybr/middleware"
+	"github.com/strick-j/cybr-sdk-alpha/service/privilegecloud/types"
+	"github.com/strick-j/smithy-go/middleware"
+	smithyhttp "github.com/strick-j/smithy-go/transport/http"
+)
+
+// Connects with an account through PSM, returning the RDP file of the
+// connection, or the connection token of the PSM HTML5 gateway. Depending on
+// the policy of the account's safe, the connection requires a reason, a ticket
+// of a ticketing system, or the confirmation of an access request, see
+// CreateRequest. See WriteRDPFile to save the RDP file.
+func (c *Client) ConnectAccount(ctx context.Context, params *ConnectAccountInput, optFns ...func(*Options)) (*ConnectAccountOutput, error) {
+	if params == nil {
+		params = &ConnectAccountInput{}
+	}
+
+	result, metadata, err := c.invokeOperation(ctx, "ConnectAccount", params, optFns, c.addOperationConnectAccountMiddlewares)
+	if err != nil {
+		return nil, err
+	}
+
+	out := result.(*ConnectAccountOutput)
+	out.ResultMetadata = metadata
+	return out, nil
+}
+
+type ConnectAccountInput struct {
+	// The unique ID of the account.
+	//
+	// This member is required.
+	AccountId *string
+
+	// The ID of the PSM connection component the account is connected with, e.g.
+	// PSM-RDP.
+	//
+	// This member is required.
+	ConnectionComponent *string
+
+	// The parameters of the connection, overriding the defaults of the connection
+	// component.
+	ConnectionParams *types.ConnectionParameters
+
+	// The reason for connecting with the account, if required by the policy of the
+	// account's platform.
+	Reason *string
+
+	// The ID of the ticket that authorizes the connection.
+	TicketId *string
+
+	// The name of the ticketing system of the ticket that authorizes the
+	// connection.
+	TicketingSystemName *string
+}
+
+type ConnectAccountOutput struct {
+	// The connection token posted to the HTML5 gateway to open the session.
+	PSMGWRequest *string
+
+	// The URL of the PSM HTML5 gateway the session is opened at.
+	PSMGWURL *string
+
+	// The contents of the RDP file of the connection, opened with a Remote Desktop
+	// client.
+	//
+	// Set if the response body is not JSON.
+	RDPFile []byte
+
+	// Metadata pertaining to the operation's result.
+	ResultMetadata middleware.Metadata
+}
+
+func (c *Client) addOperationConnectAccountMiddlewares(stack *middleware.Stack, options Options) (err error) {
+	if err := stack.Serialize.Add(&setOperationInputMiddleware{}, middleware.After); err != nil {
+		return err
+	}
+	err = stack.Serialize.Add(&cybrRestjson_serializeOpConnectAccount{}, middleware.After)
+	if err != nil {
+		return err
+	}
+	err = stack.Deserialize.Add(&cybrRestjson_deserializeOpConnectAccount{}, middleware.After)
+	if err != nil {
+		return err
+	}
+	if err := addProtocolFinalizerMiddlewares(stack, options, "ConnectAccount"); err != nil {
+		return fmt.Errorf("add protocol finalizers: %v", err)
+	}
+
+	if err = addSetLoggerMiddleware(stack, options); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddClientRequestIDMiddleware(stack); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddRequestAttemptMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addUserAgentMiddleware(stack, options); err != nil {
+		return err
+	}
+	if err = smithyhttp.AddComputeContentLengthMiddleware(stack); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddRawResponseToMetadata(stack); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddRecordResponseTiming(stack); err != nil {
+		return err
+	}
+	if err = smithyhttp.AddErrorCloseResponseBodyMiddleware(stack); err != nil {
+		return err
+	}
+	if err = smithyhttp.AddCloseResponseBodyMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addOpConnectAccountValidationMiddleware(stack); err != nil {
+		return err
+	}
+	if err = stack.Initialize.Add(newServiceMetadataMiddleware_opConnectAccount(options.Subdomain, options.Domain), middleware.Before); err != nil {
+		return err
+	}
+	if err = addRequestIDRetrieverMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addResponseErrorMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addStreamingRequestResponseLogging(stack, options); err != nil {
+		return err
+	}
+	if err = addRateLimitMiddleware(stack, options); err != nil {
+		return err
+	}
+	if err = addTracingMiddleware(stack, options); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func newServiceMetadataMiddleware_opConnectAccount(subdomain string, domain string) *cybrmiddleware.RegisterServiceMetadata {
+	return &cybrmiddleware.RegisterServiceMetadata{
+		Subdomain:     subdomain,
+		Domain:        domain,
+		ServiceID:     ServiceID,
+		OperationName: "ConnectAccount",
+	}
+}
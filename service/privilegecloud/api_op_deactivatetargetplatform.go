@@ -0,0 +1,118 @@
+// Code generated by internal/codegen DO NOT EDIT.
+
+package privilegecloud
+
+import (
+	"context"
+	"fmt"
+
+	cybrmiddleware "github.com/strick-j/cybr-sdk-alpha/cybr/middleware"
+	"github.com/strick-j/smithy-go/middleware"
+	smithyhttp "github.com/strick-j/smithy-go/transport/http"
+)
+
+// Deactivates a target platform. The accounts of the platform are kept, but no
+// accounts can be added with it.
+func (c *Client) DeactivateTargetPlatform(ctx context.Context, params *DeactivateTargetPlatformInput, optFns ...func(*Options)) (*DeactivateTargetPlatformOutput, error) {
+	if params == nil {
+		params = &DeactivateTargetPlatformInput{}
+	}
+
+	result, metadata, err := c.invokeOperation(ctx, "DeactivateTargetPlatform", params, optFns, c.addOperationDeactivateTargetPlatformMiddlewares)
+	if err != nil {
+		return nil, err
+	}
+
+	out := result.(*DeactivateTargetPlatformOutput)
+	out.ResultMetadata = metadata
+	return out, nil
+}
+
+type DeactivateTargetPlatformInput struct {
+	// The unique numeric ID of the target platform.
+	//
+	// This member is required.
+	Id *int32
+}
+
+type DeactivateTargetPlatformOutput struct {
+	// Metadata pertaining to the operation's result.
+	ResultMetadata middleware.Metadata
+}
+
+func (c *Client) addOperationDeactivateTargetPlatformMiddlewares(stack *middleware.Stack, options Options) (err error) {
+	if err := stack.Serialize.Add(&setOperationInputMiddleware{}, middleware.After); err != nil {
+		return err
+	}
+	err = stack.Serialize.Add(&cybrRestjson_serializeOpDeactivateTargetPlatform{}, middleware.After)
+	if err != nil {
+		return err
+	}
+	err = stack.Deserialize.Add(&cybrRestjson_deserializeOpDeactivateTargetPlatform{}, middleware.After)
+	if err != nil {
+		return err
+	}
+	if err := addProtocolFinalizerMiddlewares(stack, options, "DeactivateTargetPlatform"); err != nil {
+		return fmt.Errorf("add protocol finalizers: %v", err)
+	}
+
+	if err = addSetLoggerMiddleware(stack, options); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddClientRequestIDMiddleware(stack); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddRequestAttemptMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addUserAgentMiddleware(stack, options); err != nil {
+		return err
+	}
+	if err = smithyhttp.AddComputeContentLengthMiddleware(stack); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddRawResponseToMetadata(stack); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddRecordResponseTiming(stack); err != nil {
+		return err
+	}
+	if err = smithyhttp.AddErrorCloseResponseBodyMiddleware(stack); err != nil {
+		return err
+	}
+	if err = smithyhttp.AddCloseResponseBodyMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addOpDeactivateTargetPlatformValidationMiddleware(stack); err != nil {
+		return err
+	}
+	if err = stack.Initialize.Add(newServiceMetadataMiddleware_opDeactivateTargetPlatform(options.Subdomain, options.Domain), middleware.Before); err != nil {
+		return err
+	}
+	if err = addRequestIDRetrieverMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addResponseErrorMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addRequestResponseLogging(stack, options); err != nil {
+		return err
+	}
+	if err = addRateLimitMiddleware(stack, options); err != nil {
+		return err
+	}
+	if err = addTracingMiddleware(stack, options); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func newServiceMetadataMiddleware_opDeactivateTargetPlatform(subdomain string, domain string) *cybrmiddleware.RegisterServiceMetadata {
+	return &cybrmiddleware.RegisterServiceMetadata{
+		Subdomain:     subdomain,
+		Domain:        domain,
+		ServiceID:     ServiceID,
+		OperationName: "DeactivateTargetPlatform",
+	}
+}
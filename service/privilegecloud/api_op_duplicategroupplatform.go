@@ -0,0 +1,138 @@
+// Code generated by internal/codegen DO NOT EDIT.
+
+package privilegecloud
+
+import (
+	"context"
+	"fmt"
+
+	cybrmiddleware "github.com/strick-j/cybr-sdk-alpha/cybr/middleware"
+	"github.com/strick-j/smithy-go/middleware"
+	smithyhttp "github.com/strick-j/smithy-go/transport/http"
+)
+
+// Adds a new group platform with the settings of an existing one, e.g. to
+// customize a built-in platform.
+func (c *Client) DuplicateGroupPlatform(ctx context.Context, params *DuplicateGroupPlatformInput, optFns ...func(*Options)) (*DuplicateGroupPlatformOutput, error) {
+	if params == nil {
+		params = &DuplicateGroupPlatformInput{}
+	}
+
+	result, metadata, err := c.invokeOperation(ctx, "DuplicateGroupPlatform", params, optFns, c.addOperationDuplicateGroupPlatformMiddlewares)
+	if err != nil {
+		return nil, err
+	}
+
+	out := result.(*DuplicateGroupPlatformOutput)
+	out.ResultMetadata = metadata
+	return out, nil
+}
+
+type DuplicateGroupPlatformInput struct {
+	// The unique numeric ID of the group platform.
+	//
+	// This member is required.
+	Id *int32
+
+	// The name of the new platform.
+	//
+	// This member is required.
+	Name *string
+
+	// The description of the new platform.
+	Description *string
+}
+
+type DuplicateGroupPlatformOutput struct {
+	// The description of the platform.
+	Description *string
+
+	// The unique numeric ID of the new platform.
+	Id *int32
+
+	// The name of the platform.
+	Name *string
+
+	// The unique ID of the platform, e.g. WinDomain.
+	PlatformId *string
+
+	// Metadata pertaining to the operation's result.
+	ResultMetadata middleware.Metadata
+}
+
+func (c *Client) addOperationDuplicateGroupPlatformMiddlewares(stack *middleware.Stack, options Options) (err error) {
+	if err := stack.Serialize.Add(&setOperationInputMiddleware{}, middleware.After); err != nil {
+		return err
+	}
+	err = stack.Serialize.Add(&cybrRestjson_serializeOpDuplicateGroupPlatform{}, middleware.After)
+	if err != nil {
+		return err
+	}
+	err = stack.Deserialize.Add(&cybrRestjson_deserializeOpDuplicateGroupPlatform{}, middleware.After)
+	if err != nil {
+		return err
+	}
+	if err := addProtocolFinalizerMiddlewares(stack, options, "DuplicateGroupPlatform"); err != nil {
+		return fmt.Errorf("add protocol finalizers: %v", err)
+	}
+
+	if err = addSetLoggerMiddleware(stack, options); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddClientRequestIDMiddleware(stack); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddRequestAttemptMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addUserAgentMiddleware(stack, options); err != nil {
+		return err
+	}
+	if err = smithyhttp.AddComputeContentLengthMiddleware(stack); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddRawResponseToMetadata(stack); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddRecordResponseTiming(stack); err != nil {
+		return err
+	}
+	if err = smithyhttp.AddErrorCloseResponseBodyMiddleware(stack); err != nil {
+		return err
+	}
+	if err = smithyhttp.AddCloseResponseBodyMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addOpDuplicateGroupPlatformValidationMiddleware(stack); err != nil {
+		return err
+	}
+	if err = stack.Initialize.Add(newServiceMetadataMiddleware_opDuplicateGroupPlatform(options.Subdomain, options.Domain), middleware.Before); err != nil {
+		return err
+	}
+	if err = addRequestIDRetrieverMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addResponseErrorMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addRequestResponseLogging(stack, options); err != nil {
+		return err
+	}
+	if err = addRateLimitMiddleware(stack, options); err != nil {
+		return err
+	}
+	if err = addTracingMiddleware(stack, options); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func newServiceMetadataMiddleware_opDuplicateGroupPlatform(subdomain string, domain string) *cybrmiddleware.RegisterServiceMetadata {
+	return &cybrmiddleware.RegisterServiceMetadata{
+		Subdomain:     subdomain,
+		Domain:        domain,
+		ServiceID:     ServiceID,
+		OperationName: "DuplicateGroupPlatform",
+	}
+}
@@ -0,0 +1,138 @@
+// Code generated by internal/codegen DO NOT EDIT.
+
+package privilegecloud
+
+import (
+	"context"
+	"fmt"
+
+	cybrmiddleware "github.com/strick-j/cybr-sdk-alpha/cybr/middleware"
+	"github.com/strick-j/smithy-go/middleware"
+	smithyhttp "github.com/strick-j/smithy-go/transport/http"
+)
+
+// Adds a new target platform with the settings of an existing one, e.g. to
+// customize a built-in platform.
+func (c *Client) DuplicateTargetPlatform(ctx context.Context, params *DuplicateTargetPlatformInput, optFns ...func(*Options)) (*DuplicateTargetPlatformOutput, error) {
+	if params == nil {
+		params = &DuplicateTargetPlatformInput{}
+	}
+
+	result, metadata, err := c.invokeOperation(ctx, "DuplicateTargetPlatform", params, optFns, c.addOperationDuplicateTargetPlatformMiddlewares)
+	if err != nil {
+		return nil, err
+	}
+
+	out := result.(*DuplicateTargetPlatformOutput)
+	out.ResultMetadata = metadata
+	return out, nil
+}
+
+type DuplicateTargetPlatformInput struct {
+	// The unique numeric ID of the target platform.
+	//
+	// This member is required.
+	Id *int32
+
+	// The name of the new platform.
+	//
+	// This member is required.
+	Name *string
+
+	// The description of the new platform.
+	Description *string
+}
+
+type DuplicateTargetPlatformOutput struct {
+	// The description of the platform.
+	Description *string
+
+	// The unique numeric ID of the new platform.
+	Id *int32
+
+	// The name of the platform.
+	Name *string
+
+	// The unique ID of the platform, e.g. WinDomain.
+	PlatformId *string
+
+	// Metadata pertaining to the operation's result.
+	ResultMetadata middleware.Metadata
+}
+
+func (c *Client) addOperationDuplicateTargetPlatformMiddlewares(stack *middleware.Stack, options Options) (err error) {
+	if err := stack.Serialize.Add(&setOperationInputMiddleware{}, middleware.After); err != nil {
+		return err
+	}
+	err = stack.Serialize.Add(&cybrRestjson_serializeOpDuplicateTargetPlatform{}, middleware.After)
+	if err != nil {
+		return err
+	}
+	err = stack.Deserialize.Add(&cybrRestjson_deserializeOpDuplicateTargetPlatform{}, middleware.After)
+	if err != nil {
+		return err
+	}
+	if err := addProtocolFinalizerMiddlewares(stack, options, "DuplicateTargetPlatform"); err != nil {
+		return fmt.Errorf("add protocol finalizers: %v", err)
+	}
+
+	if err = addSetLoggerMiddleware(stack, options); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddClientRequestIDMiddleware(stack); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddRequestAttemptMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addUserAgentMiddleware(stack, options); err != nil {
+		return err
+	}
+	if err = smithyhttp.AddComputeContentLengthMiddleware(stack); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddRawResponseToMetadata(stack); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddRecordResponseTiming(stack); err != nil {
+		return err
+	}
+	if err = smithyhttp.AddErrorCloseResponseBodyMiddleware(stack); err != nil {
+		return err
+	}
+	if err = smithyhttp.AddCloseResponseBodyMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addOpDuplicateTargetPlatformValidationMiddleware(stack); err != nil {
+		return err
+	}
+	if err = stack.Initialize.Add(newServiceMetadataMiddleware_opDuplicateTargetPlatform(options.Subdomain, options.Domain), middleware.Before); err != nil {
+		return err
+	}
+	if err = addRequestIDRetrieverMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addResponseErrorMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addRequestResponseLogging(stack, options); err != nil {
+		return err
+	}
+	if err = addRateLimitMiddleware(stack, options); err != nil {
+		return err
+	}
+	if err = addTracingMiddleware(stack, options); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func newServiceMetadataMiddleware_opDuplicateTargetPlatform(subdomain string, domain string) *cybrmiddleware.RegisterServiceMetadata {
+	return &cybrmiddleware.RegisterServiceMetadata{
+		Subdomain:     subdomain,
+		Domain:        domain,
+		ServiceID:     ServiceID,
+		OperationName: "DuplicateTargetPlatform",
+	}
+}
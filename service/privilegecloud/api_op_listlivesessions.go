@@ -0,0 +1,233 @@
+// Code generated by internal/codegen DO NOT EDIT.
+
+package privilegecloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cybrmiddleware "github.com/strick-j/cybr-sdk-alpha/cybr/middleware"
+	"github.com/strick-j/cybr-sdk-alpha/service/privilegecloud/types"
+	"github.com/strick-j/smithy-go/middleware"
+	smithyhttp "github.com/strick-j/smithy-go/transport/http"
+)
+
+// Returns the live PSM sessions the authenticated user is authorized to
+// monitor.
+func (c *Client) ListLiveSessions(ctx context.Context, params *ListLiveSessionsInput, optFns ...func(*Options)) (*ListLiveSessionsOutput, error) {
+	if params == nil {
+		params = &ListLiveSessionsInput{}
+	}
+
+	result, metadata, err := c.invokeOperation(ctx, "ListLiveSessions", params, optFns, c.addOperationListLiveSessionsMiddlewares)
+	if err != nil {
+		return nil, err
+	}
+
+	out := result.(*ListLiveSessionsOutput)
+	out.ResultMetadata = metadata
+	return out, nil
+}
+
+type ListLiveSessionsInput struct {
+	// A list of activities, separated by a comma, that occurred in the live
+	// sessions, e.g. a command that was run.
+	Activities *string
+
+	// The time from which the live sessions started.
+	FromTime *time.Time
+
+	// The maximum number of live sessions that are returned. Defaults to 25, the
+	// maximum is 1000.
+	Limit *int32
+
+	// The number of live sessions that are skipped in the list.
+	Offset *int32
+
+	// The name of the safe of the accounts of the live sessions.
+	Safe *string
+
+	// A list of keywords matched against the properties of the live sessions, e.g.
+	// the name of the user that connected, separated by a space.
+	Search *string
+
+	// The property, and optionally the asc or desc direction, the live sessions are
+	// sorted by, e.g. Start desc.
+	Sort *string
+
+	// The time until which the live sessions started.
+	ToTime *time.Time
+}
+
+type ListLiveSessionsOutput struct {
+	// The live sessions of the page.
+	LiveSessions []types.Session
+
+	// The total number of live sessions that match the request.
+	Total *int32
+
+	// Metadata pertaining to the operation's result.
+	ResultMetadata middleware.Metadata
+}
+
+func (c *Client) addOperationListLiveSessionsMiddlewares(stack *middleware.Stack, options Options) (err error) {
+	if err := stack.Serialize.Add(&setOperationInputMiddleware{}, middleware.After); err != nil {
+		return err
+	}
+	err = stack.Serialize.Add(&cybrRestjson_serializeOpListLiveSessions{}, middleware.After)
+	if err != nil {
+		return err
+	}
+	err = stack.Deserialize.Add(&cybrRestjson_deserializeOpListLiveSessions{}, middleware.After)
+	if err != nil {
+		return err
+	}
+	if err := addProtocolFinalizerMiddlewares(stack, options, "ListLiveSessions"); err != nil {
+		return fmt.Errorf("add protocol finalizers: %v", err)
+	}
+
+	if err = addSetLoggerMiddleware(stack, options); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddClientRequestIDMiddleware(stack); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddRequestAttemptMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addUserAgentMiddleware(stack, options); err != nil {
+		return err
+	}
+	if err = smithyhttp.AddComputeContentLengthMiddleware(stack); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddRawResponseToMetadata(stack); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddRecordResponseTiming(stack); err != nil {
+		return err
+	}
+	if err = smithyhttp.AddErrorCloseResponseBodyMiddleware(stack); err != nil {
+		return err
+	}
+	if err = smithyhttp.AddCloseResponseBodyMiddleware(stack); err != nil {
+		return err
+	}
+	if err = stack.Initialize.Add(newServiceMetadataMiddleware_opListLiveSessions(options.Subdomain, options.Domain), middleware.Before); err != nil {
+		return err
+	}
+	if err = addRequestIDRetrieverMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addResponseErrorMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addRequestResponseLogging(stack, options); err != nil {
+		return err
+	}
+	if err = addRateLimitMiddleware(stack, options); err != nil {
+		return err
+	}
+	if err = addTracingMiddleware(stack, options); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ListLiveSessionsAPIClient is a client that implements the ListLiveSessions operation.
+type ListLiveSessionsAPIClient interface {
+	ListLiveSessions(context.Context, *ListLiveSessionsInput, ...func(*Options)) (*ListLiveSessionsOutput, error)
+}
+
+var _ ListLiveSessionsAPIClient = (*Client)(nil)
+
+// ListLiveSessionsPaginatorOptions is the paginator options for ListLiveSessions
+type ListLiveSessionsPaginatorOptions struct {
+	// The maximum number of items to return per page. The service default is
+	// used if not set.
+	Limit int32
+}
+
+// ListLiveSessionsPaginator is a paginator for ListLiveSessions
+type ListLiveSessionsPaginator struct {
+	options ListLiveSessionsPaginatorOptions
+	client  ListLiveSessionsAPIClient
+	params  *ListLiveSessionsInput
+	offset  int32
+	done    bool
+}
+
+// NewListLiveSessionsPaginator returns a new ListLiveSessionsPaginator
+func NewListLiveSessionsPaginator(client ListLiveSessionsAPIClient, params *ListLiveSessionsInput, optFns ...func(*ListLiveSessionsPaginatorOptions)) *ListLiveSessionsPaginator {
+	if params == nil {
+		params = &ListLiveSessionsInput{}
+	}
+
+	options := ListLiveSessionsPaginatorOptions{}
+	if params.Limit != nil {
+		options.Limit = *params.Limit
+	}
+
+	for _, fn := range optFns {
+		fn(&options)
+	}
+
+	var offset int32
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+
+	return &ListLiveSessionsPaginator{
+		options: options,
+		client:  client,
+		params:  params,
+		offset:  offset,
+	}
+}
+
+// HasMorePages returns a boolean indicating whether more pages are available
+func (p *ListLiveSessionsPaginator) HasMorePages() bool {
+	return !p.done
+}
+
+// NextPage retrieves the next ListLiveSessions page.
+func (p *ListLiveSessionsPaginator) NextPage(ctx context.Context, optFns ...func(*Options)) (*ListLiveSessionsOutput, error) {
+	if !p.HasMorePages() {
+		return nil, fmt.Errorf("no more pages available")
+	}
+
+	params := *p.params
+	offset := p.offset
+	params.Offset = &offset
+
+	var limit *int32
+	if p.options.Limit > 0 {
+		limit = &p.options.Limit
+	}
+	params.Limit = limit
+
+	result, err := p.client.ListLiveSessions(ctx, &params, optFns...)
+	if err != nil {
+		return nil, err
+	}
+
+	count := int32(len(result.LiveSessions))
+	p.offset += count
+	p.done = count == 0
+	if result.Total != nil && p.offset >= *result.Total {
+		p.done = true
+	}
+
+	return result, nil
+}
+
+func newServiceMetadataMiddleware_opListLiveSessions(subdomain string, domain string) *cybrmiddleware.RegisterServiceMetadata {
+	return &cybrmiddleware.RegisterServiceMetadata{
+		Subdomain:     subdomain,
+		Domain:        domain,
+		ServiceID:     ServiceID,
+		OperationName: "ListLiveSessions",
+	}
+}
@@ -0,0 +1,233 @@
+// Code generated by internal/codegen DO NOT EDIT.
+
+package privilegecloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cybrmiddleware "github.com/strick-j/cybr-sdk-alpha/cybr/middleware"
+	"github.com/strick-j/cybr-sdk-alpha/service/privilegecloud/types"
+	"github.com/strick-j/smithy-go/middleware"
+	smithyhttp "github.com/strick-j/smithy-go/transport/http"
+)
+
+// Returns the recordings of the PSM sessions the authenticated user is
+// authorized to view.
+func (c *Client) ListRecordings(ctx context.Context, params *ListRecordingsInput, optFns ...func(*Options)) (*ListRecordingsOutput, error) {
+	if params == nil {
+		params = &ListRecordingsInput{}
+	}
+
+	result, metadata, err := c.invokeOperation(ctx, "ListRecordings", params, optFns, c.addOperationListRecordingsMiddlewares)
+	if err != nil {
+		return nil, err
+	}
+
+	out := result.(*ListRecordingsOutput)
+	out.ResultMetadata = metadata
+	return out, nil
+}
+
+type ListRecordingsInput struct {
+	// A list of activities, separated by a comma, that occurred in the recordings,
+	// e.g. a command that was run.
+	Activities *string
+
+	// The time from which the recordings started.
+	FromTime *time.Time
+
+	// The maximum number of recordings that are returned. Defaults to 25, the
+	// maximum is 1000.
+	Limit *int32
+
+	// The number of recordings that are skipped in the list.
+	Offset *int32
+
+	// The name of the safe of the accounts of the recordings.
+	Safe *string
+
+	// A list of keywords matched against the properties of the recordings, e.g. the
+	// name of the user that connected, separated by a space.
+	Search *string
+
+	// The property, and optionally the asc or desc direction, the recordings are
+	// sorted by, e.g. Start desc.
+	Sort *string
+
+	// The time until which the recordings started.
+	ToTime *time.Time
+}
+
+type ListRecordingsOutput struct {
+	// The recordings of the page.
+	Recordings []types.Session
+
+	// The total number of recordings that match the request.
+	Total *int32
+
+	// Metadata pertaining to the operation's result.
+	ResultMetadata middleware.Metadata
+}
+
+func (c *Client) addOperationListRecordingsMiddlewares(stack *middleware.Stack, options Options) (err error) {
+	if err := stack.Serialize.Add(&setOperationInputMiddleware{}, middleware.After); err != nil {
+		return err
+	}
+	err = stack.Serialize.Add(&cybrRestjson_serializeOpListRecordings{}, middleware.After)
+	if err != nil {
+		return err
+	}
+	err = stack.Deserialize.Add(&cybrRestjson_deserializeOpListRecordings{}, middleware.After)
+	if err != nil {
+		return err
+	}
+	if err := addProtocolFinalizerMiddlewares(stack, options, "ListRecordings"); err != nil {
+		return fmt.Errorf("add protocol finalizers: %v", err)
+	}
+
+	if err = addSetLoggerMiddleware(stack, options); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddClientRequestIDMiddleware(stack); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddRequestAttemptMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addUserAgentMiddleware(stack, options); err != nil {
+		return err
+	}
+	if err = smithyhttp.AddComputeContentLengthMiddleware(stack); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddRawResponseToMetadata(stack); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddRecordResponseTiming(stack); err != nil {
+		return err
+	}
+	if err = smithyhttp.AddErrorCloseResponseBodyMiddleware(stack); err != nil {
+		return err
+	}
+	if err = smithyhttp.AddCloseResponseBodyMiddleware(stack); err != nil {
+		return err
+	}
+	if err = stack.Initialize.Add(newServiceMetadataMiddleware_opListRecordings(options.Subdomain, options.Domain), middleware.Before); err != nil {
+		return err
+	}
+	if err = addRequestIDRetrieverMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addResponseErrorMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addRequestResponseLogging(stack, options); err != nil {
+		return err
+	}
+	if err = addRateLimitMiddleware(stack, options); err != nil {
+		return err
+	}
+	if err = addTracingMiddleware(stack, options); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ListRecordingsAPIClient is a client that implements the ListRecordings operation.
+type ListRecordingsAPIClient interface {
+	ListRecordings(context.Context, *ListRecordingsInput, ...func(*Options)) (*ListRecordingsOutput, error)
+}
+
+var _ ListRecordingsAPIClient = (*Client)(nil)
+
+// ListRecordingsPaginatorOptions is the paginator options for ListRecordings
+type ListRecordingsPaginatorOptions struct {
+	// The maximum number of items to return per page. The service default is
+	// used if not set.
+	Limit int32
+}
+
+// ListRecordingsPaginator is a paginator for ListRecordings
+type ListRecordingsPaginator struct {
+	options ListRecordingsPaginatorOptions
+	client  ListRecordingsAPIClient
+	params  *ListRecordingsInput
+	offset  int32
+	done    bool
+}
+
+// NewListRecordingsPaginator returns a new ListRecordingsPaginator
+func NewListRecordingsPaginator(client ListRecordingsAPIClient, params *ListRecordingsInput, optFns ...func(*ListRecordingsPaginatorOptions)) *ListRecordingsPaginator {
+	if params == nil {
+		params = &ListRecordingsInput{}
+	}
+
+	options := ListRecordingsPaginatorOptions{}
+	if params.Limit != nil {
+		options.Limit = *params.Limit
+	}
+
+	for _, fn := range optFns {
+		fn(&options)
+	}
+
+	var offset int32
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+
+	return &ListRecordingsPaginator{
+		options: options,
+		client:  client,
+		params:  params,
+		offset:  offset,
+	}
+}
+
+// HasMorePages returns a boolean indicating whether more pages are available
+func (p *ListRecordingsPaginator) HasMorePages() bool {
+	return !p.done
+}
+
+// NextPage retrieves the next ListRecordings page.
+func (p *ListRecordingsPaginator) NextPage(ctx context.Context, optFns ...func(*Options)) (*ListRecordingsOutput, error) {
+	if !p.HasMorePages() {
+		return nil, fmt.Errorf("no more pages available")
+	}
+
+	params := *p.params
+	offset := p.offset
+	params.Offset = &offset
+
+	var limit *int32
+	if p.options.Limit > 0 {
+		limit = &p.options.Limit
+	}
+	params.Limit = limit
+
+	result, err := p.client.ListRecordings(ctx, &params, optFns...)
+	if err != nil {
+		return nil, err
+	}
+
+	count := int32(len(result.Recordings))
+	p.offset += count
+	p.done = count == 0
+	if result.Total != nil && p.offset >= *result.Total {
+		p.done = true
+	}
+
+	return result, nil
+}
+
+func newServiceMetadataMiddleware_opListRecordings(subdomain string, domain string) *cybrmiddleware.RegisterServiceMetadata {
+	return &cybrmiddleware.RegisterServiceMetadata{
+		Subdomain:     subdomain,
+		Domain:        domain,
+		ServiceID:     ServiceID,
+		OperationName: "ListRecordings",
+	}
+}
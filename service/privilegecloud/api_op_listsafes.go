@@ -0,0 +1,228 @@
+// Code generated by internal/codegen DO NOT EDIT.
+
+package privilegecloud
+
+import (
+	"context"
+	"fmt"
+
+	cybrmiddleware "github.com/strick-j/cybr-sdk-alpha/cybr/middleware"
+	"github.com/strick-j/cybr-sdk-alpha/service/privilegecloud/types"
+	"github.com/strick-j/smithy-go/middleware"
+	smithyhttp "github.com/strick-j/smithy-go/transport/http"
+)
+
+// Returns a list of all the safes the authenticated user is a member of.
+func (c *Client) ListSafes(ctx context.Context, params *ListSafesInput, optFns ...func(*Options)) (*ListSafesOutput, error) {
+	if params == nil {
+		params = &ListSafesInput{}
+	}
+
+	result, metadata, err := c.invokeOperation(ctx, "ListSafes", params, optFns, c.addOperationListSafesMiddlewares)
+	if err != nil {
+		return nil, err
+	}
+
+	out := result.(*ListSafesOutput)
+	out.ResultMetadata = metadata
+	return out, nil
+}
+
+type ListSafesInput struct {
+	// Whether all the safe details are returned, or only the safe names and IDs.
+	ExtendedDetails *bool
+
+	// Whether the accounts of each safe are returned.
+	IncludeAccounts *bool
+
+	// The maximum number of safes that are returned. Defaults to 25, the maximum is
+	// 1000.
+	Limit *int32
+
+	// The number of safes that are skipped in the list.
+	Offset *int32
+
+	// A list of keywords to search for in the safe names, separated by a space.
+	Search *string
+
+	// The direction, asc or desc, the safes are sorted by name in.
+	Sort *string
+}
+
+type ListSafesOutput struct {
+	// The total number of safes that match the request.
+	Count *int32
+
+	// The relative URL of the next page. Not set on the last page.
+	NextLink *string
+
+	// The safes of the page.
+	Value []types.Safe
+
+	// Metadata pertaining to the operation's result.
+	ResultMetadata middleware.Metadata
+}
+
+func (c *Client) addOperationListSafesMiddlewares(stack *middleware.Stack, options Options) (err error) {
+	if err := stack.Serialize.Add(&setOperationInputMiddleware{}, middleware.After); err != nil {
+		return err
+	}
+	err = stack.Serialize.Add(&cybrRestjson_serializeOpListSafes{}, middleware.After)
+	if err != nil {
+		return err
+	}
+	err = stack.Deserialize.Add(&cybrRestjson_deserializeOpListSafes{}, middleware.After)
+	if err != nil {
+		return err
+	}
+	if err := addProtocolFinalizerMiddlewares(stack, options, "ListSafes"); err != nil {
+		return fmt.Errorf("add protocol finalizers: %v", err)
+	}
+
+	if err = addSetLoggerMiddleware(stack, options); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddClientRequestIDMiddleware(stack); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddRequestAttemptMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addUserAgentMiddleware(stack, options); err != nil {
+		return err
+	}
+	if err = smithyhttp.AddComputeContentLengthMiddleware(stack); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddRawResponseToMetadata(stack); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddRecordResponseTiming(stack); err != nil {
+		return err
+	}
+	if err = smithyhttp.AddErrorCloseResponseBodyMiddleware(stack); err != nil {
+		return err
+	}
+	if err = smithyhttp.AddCloseResponseBodyMiddleware(stack); err != nil {
+		return err
+	}
+	if err = stack.Initialize.Add(newServiceMetadataMiddleware_opListSafes(options.Subdomain, options.Domain), middleware.Before); err != nil {
+		return err
+	}
+	if err = addRequestIDRetrieverMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addResponseErrorMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addRequestResponseLogging(stack, options); err != nil {
+		return err
+	}
+	if err = addRateLimitMiddleware(stack, options); err != nil {
+		return err
+	}
+	if err = addTracingMiddleware(stack, options); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ListSafesAPIClient is a client that implements the ListSafes operation.
+type ListSafesAPIClient interface {
+	ListSafes(context.Context, *ListSafesInput, ...func(*Options)) (*ListSafesOutput, error)
+}
+
+var _ ListSafesAPIClient = (*Client)(nil)
+
+// ListSafesPaginatorOptions is the paginator options for ListSafes
+type ListSafesPaginatorOptions struct {
+	// The maximum number of items to return per page. The service default is
+	// used if not set.
+	Limit int32
+}
+
+// ListSafesPaginator is a paginator for ListSafes
+type ListSafesPaginator struct {
+	options ListSafesPaginatorOptions
+	client  ListSafesAPIClient
+	params  *ListSafesInput
+	offset  int32
+	done    bool
+}
+
+// NewListSafesPaginator returns a new ListSafesPaginator
+func NewListSafesPaginator(client ListSafesAPIClient, params *ListSafesInput, optFns ...func(*ListSafesPaginatorOptions)) *ListSafesPaginator {
+	if params == nil {
+		params = &ListSafesInput{}
+	}
+
+	options := ListSafesPaginatorOptions{}
+	if params.Limit != nil {
+		options.Limit = *params.Limit
+	}
+
+	for _, fn := range optFns {
+		fn(&options)
+	}
+
+	var offset int32
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+
+	return &ListSafesPaginator{
+		options: options,
+		client:  client,
+		params:  params,
+		offset:  offset,
+	}
+}
+
+// HasMorePages returns a boolean indicating whether more pages are available
+func (p *ListSafesPaginator) HasMorePages() bool {
+	return !p.done
+}
+
+// NextPage retrieves the next ListSafes page.
+func (p *ListSafesPaginator) NextPage(ctx context.Context, optFns ...func(*Options)) (*ListSafesOutput, error) {
+	if !p.HasMorePages() {
+		return nil, fmt.Errorf("no more pages available")
+	}
+
+	params := *p.params
+	offset := p.offset
+	params.Offset = &offset
+
+	var limit *int32
+	if p.options.Limit > 0 {
+		limit = &p.options.Limit
+	}
+	params.Limit = limit
+
+	result, err := p.client.ListSafes(ctx, &params, optFns...)
+	if err != nil {
+		return nil, err
+	}
+
+	count := int32(len(result.Value))
+	p.offset += count
+	p.done = count == 0
+	if result.NextLink == nil || len(*result.NextLink) == 0 {
+		p.done = true
+	}
+	if result.Count != nil && p.offset >= *result.Count {
+		p.done = true
+	}
+
+	return result, nil
+}
+
+func newServiceMetadataMiddleware_opListSafes(subdomain string, domain string) *cybrmiddleware.RegisterServiceMetadata {
+	return &cybrmiddleware.RegisterServiceMetadata{
+		Subdomain:     subdomain,
+		Domain:        domain,
+		ServiceID:     ServiceID,
+		OperationName: "ListSafes",
+	}
+}
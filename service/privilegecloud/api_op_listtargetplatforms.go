@@ -0,0 +1,149 @@
+// Code generated by internal/codegen DO NOT EDIT.
+
+package privilegecloud
+
+import (
+	"context"
+	"fmt"
+
+	cybrmiddleware "github.com/strick-j/cybr-sdk-alpha/cybr/middleware"
+	"github.com/strick-j/cybr-sdk-alpha/service/privilegecloud/types"
+	"github.com/strick-j/smithy-go/middleware"
+	smithyhttp "github.com/strick-j/smithy-go/transport/http"
+)
+
+// Returns the target platforms the authenticated user has permissions to view.
+func (c *Client) ListTargetPlatforms(ctx context.Context, params *ListTargetPlatformsInput, optFns ...func(*Options)) (*ListTargetPlatformsOutput, error) {
+	if params == nil {
+		params = &ListTargetPlatformsInput{}
+	}
+
+	result, metadata, err := c.invokeOperation(ctx, "ListTargetPlatforms", params, optFns, c.addOperationListTargetPlatformsMiddlewares)
+	if err != nil {
+		return nil, err
+	}
+
+	out := result.(*ListTargetPlatformsOutput)
+	out.ResultMetadata = metadata
+	return out, nil
+}
+
+type ListTargetPlatformsInput struct {
+	// Whether only the active, or only the inactive, platforms are returned.
+	Active *bool
+
+	// Whether only the platforms that reconcile unsynchronized credentials
+	// automatically, or only the ones that do not, are returned.
+	AutomaticReconcile *bool
+
+	// Whether only the platforms that allow credentials to be changed manually, or
+	// only the ones that do not, are returned.
+	ManualChange *bool
+
+	// Whether only the platforms that allow credentials to be reconciled manually,
+	// or only the ones that do not, are returned.
+	ManualReconcile *bool
+
+	// Whether only the platforms that allow credentials to be verified manually, or
+	// only the ones that do not, are returned.
+	ManualVerify *bool
+
+	// Whether only the platforms that change credentials periodically, or only the
+	// ones that do not, are returned.
+	PeriodicChange *bool
+
+	// Whether only the platforms that verify credentials periodically, or only the
+	// ones that do not, are returned.
+	PeriodicVerify *bool
+
+	// A list of keywords to search for in the platform names, separated by a space.
+	Search *string
+
+	// The system type of the platforms that are returned, e.g. Windows.
+	SystemType *string
+}
+
+type ListTargetPlatformsOutput struct {
+	// The target platforms.
+	Platforms []types.TargetPlatform
+
+	// The number of platforms.
+	Total *int32
+
+	// Metadata pertaining to the operation's result.
+	ResultMetadata middleware.Metadata
+}
+
+func (c *Client) addOperationListTargetPlatformsMiddlewares(stack *middleware.Stack, options Options) (err error) {
+	if err := stack.Serialize.Add(&setOperationInputMiddleware{}, middleware.After); err != nil {
+		return err
+	}
+	err = stack.Serialize.Add(&cybrRestjson_serializeOpListTargetPlatforms{}, middleware.After)
+	if err != nil {
+		return err
+	}
+	err = stack.Deserialize.Add(&cybrRestjson_deserializeOpListTargetPlatforms{}, middleware.After)
+	if err != nil {
+		return err
+	}
+	if err := addProtocolFinalizerMiddlewares(stack, options, "ListTargetPlatforms"); err != nil {
+		return fmt.Errorf("add protocol finalizers: %v", err)
+	}
+
+	if err = addSetLoggerMiddleware(stack, options); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddClientRequestIDMiddleware(stack); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddRequestAttemptMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addUserAgentMiddleware(stack, options); err != nil {
+		return err
+	}
+	if err = smithyhttp.AddComputeContentLengthMiddleware(stack); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddRawResponseToMetadata(stack); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddRecordResponseTiming(stack); err != nil {
+		return err
+	}
+	if err = smithyhttp.AddErrorCloseResponseBodyMiddleware(stack); err != nil {
+		return err
+	}
+	if err = smithyhttp.AddCloseResponseBodyMiddleware(stack); err != nil {
+		return err
+	}
+	if err = stack.Initialize.Add(newServiceMetadataMiddleware_opListTargetPlatforms(options.Subdomain, options.Domain), middleware.Before); err != nil {
+		return err
+	}
+	if err = addRequestIDRetrieverMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addResponseErrorMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addRequestResponseLogging(stack, options); err != nil {
+		return err
+	}
+	if err = addRateLimitMiddleware(stack, options); err != nil {
+		return err
+	}
+	if err = addTracingMiddleware(stack, options); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func newServiceMetadataMiddleware_opListTargetPlatforms(subdomain string, domain string) *cybrmiddleware.RegisterServiceMetadata {
+	return &cybrmiddleware.RegisterServiceMetadata{
+		Subdomain:     subdomain,
+		Domain:        domain,
+		ServiceID:     ServiceID,
+		OperationName: "ListTargetPlatforms",
+	}
+}
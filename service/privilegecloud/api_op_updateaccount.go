@@ -0,0 +1,161 @@
+// Code generated by internal/codegen DO NOT EDIT.
+
+package privilegecloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cybrmiddleware "github.com/strick-j/cybr-sdk-alpha/cybr/middleware"
+	"github.com/strick-j/cybr-sdk-alpha/service/privilegecloud/types"
+	"github.com/strick-j/smithy-go/middleware"
+	smithyhttp "github.com/strick-j/smithy-go/transport/http"
+)
+
+// Updates the properties of an existing account with a list of JSON Patch
+// operations.
+func (c *Client) UpdateAccount(ctx context.Context, params *UpdateAccountInput, optFns ...func(*Options)) (*UpdateAccountOutput, error) {
+	if params == nil {
+		params = &UpdateAccountInput{}
+	}
+
+	result, metadata, err := c.invokeOperation(ctx, "UpdateAccount", params, optFns, c.addOperationUpdateAccountMiddlewares)
+	if err != nil {
+		return nil, err
+	}
+
+	out := result.(*UpdateAccountOutput)
+	out.ResultMetadata = metadata
+	return out, nil
+}
+
+type UpdateAccountInput struct {
+	// The unique ID of the account.
+	//
+	// This member is required.
+	AccountId *string
+
+	// The operations applied to the account, in order.
+	//
+	// This member is required.
+	Operations []types.PatchOperation
+}
+
+type UpdateAccountOutput struct {
+	// The address of the machine the account is used on.
+	Address *string
+
+	// The time the properties of the account were last modified.
+	CategoryModificationTime *time.Time
+
+	// The time the account was created.
+	CreatedTime *time.Time
+
+	// The unique ID of the account.
+	Id *string
+
+	// The name of the account.
+	Name *string
+
+	// The platform specific properties of the account.
+	PlatformAccountProperties map[string]string
+
+	// The ID of the platform the account is managed by.
+	PlatformId *string
+
+	// The machines an account can be used to connect to.
+	RemoteMachinesAccess *types.RemoteMachinesAccess
+
+	// The name of the safe the account is stored in.
+	SafeName *string
+
+	// The way the secret of an account is managed by the CPM.
+	SecretManagement *types.SecretManagement
+
+	// The type of the secret of an account.
+	SecretType types.SecretType
+
+	// The user name of the account.
+	UserName *string
+
+	// Metadata pertaining to the operation's result.
+	ResultMetadata middleware.Metadata
+}
+
+func (c *Client) addOperationUpdateAccountMiddlewares(stack *middleware.Stack, options Options) (err error) {
+	if err := stack.Serialize.Add(&setOperationInputMiddleware{}, middleware.After); err != nil {
+		return err
+	}
+	err = stack.Serialize.Add(&cybrRestjson_serializeOpUpdateAccount{}, middleware.After)
+	if err != nil {
+		return err
+	}
+	err = stack.Deserialize.Add(&cybrRestjson_deserializeOpUpdateAccount{}, middleware.After)
+	if err != nil {
+		return err
+	}
+	if err := addProtocolFinalizerMiddlewares(stack, options, "UpdateAccount"); err != nil {
+		return fmt.Errorf("add protocol finalizers: %v", err)
+	}
+
+	if err = addSetLoggerMiddleware(stack, options); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddClientRequestIDMiddleware(stack); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddRequestAttemptMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addUserAgentMiddleware(stack, options); err != nil {
+		return err
+	}
+	if err = smithyhttp.AddComputeContentLengthMiddleware(stack); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddRawResponseToMetadata(stack); err != nil {
+		return err
+	}
+	if err = cybrmiddleware.AddRecordResponseTiming(stack); err != nil {
+		return err
+	}
+	if err = smithyhttp.AddErrorCloseResponseBodyMiddleware(stack); err != nil {
+		return err
+	}
+	if err = smithyhttp.AddCloseResponseBodyMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addOpUpdateAccountValidationMiddleware(stack); err != nil {
+		return err
+	}
+	if err = stack.Initialize.Add(newServiceMetadataMiddleware_opUpdateAccount(options.Subdomain, options.Domain), middleware.Before); err != nil {
+		return err
+	}
+	if err = addRequestIDRetrieverMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addResponseErrorMiddleware(stack); err != nil {
+		return err
+	}
+	if err = addRequestResponseLogging(stack, options); err != nil {
+		return err
+	}
+	if err = addRateLimitMiddleware(stack, options); err != nil {
+		return err
+	}
+	if err = addTracingMiddleware(stack, options); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func newServiceMetadataMiddleware_opUpdateAccount(subdomain string, domain string) *cybrmiddleware.RegisterServiceMetadata {
+	return &cybrmiddleware.RegisterServiceMetadata{
+		Subdomain:     subdomain,
+		Domain:        domain,
+		ServiceID:     ServiceID,
+		OperationName: "UpdateAccount",
+	}
+}
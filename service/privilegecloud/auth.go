@@ -0,0 +1,111 @@
+package privilegecloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/strick-j/cybr-sdk-alpha/cybr"
+	cybrmiddleware "github.com/strick-j/cybr-sdk-alpha/cybr/middleware"
+	"github.com/strick-j/smithy-go/logging"
+	"github.com/strick-j/smithy-go/middleware"
+	smithyhttp "github.com/strick-j/smithy-go/transport/http"
+)
+
+// noAuthOperations are the operations that are not signed with the client's
+// credentials. All the Privilege Cloud operations require a session token.
+var noAuthOperations = map[string]bool{}
+
+type getIdentityMiddleware struct {
+	options   Options
+	operation string
+}
+
+func (*getIdentityMiddleware) ID() string {
+	return "GetIdentity"
+}
+
+func (m *getIdentityMiddleware) HandleFinalize(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (
+	out middleware.FinalizeOutput, metadata middleware.Metadata, err error,
+) {
+	if !requiresAuth(m.options, m.operation) {
+		return next.HandleFinalize(ctx, in)
+	}
+
+	// the credentials' expiry is adjusted by the clock skew measured for the
+	// resolved endpoint's host, unless they were issued a host.
+	if req, ok := in.Request.(*smithyhttp.Request); ok {
+		ctx = cybr.WithClockSkewHost(ctx, req.URL.Host)
+	}
+
+	creds, err := m.options.Credentials.Retrieve(ctx)
+	if err != nil {
+		return out, metadata, fmt.Errorf("failed to retrieve credentials: %w", err)
+	}
+
+	ctx = cybrmiddleware.SetSigningCredentials(ctx, creds)
+	return next.HandleFinalize(ctx, in)
+}
+
+type signRequestMiddleware struct {
+	options Options
+}
+
+func (*signRequestMiddleware) ID() string {
+	return "Signing"
+}
+
+func (m *signRequestMiddleware) HandleFinalize(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (
+	out middleware.FinalizeOutput, metadata middleware.Metadata, err error,
+) {
+	req, ok := in.Request.(*smithyhttp.Request)
+	if !ok {
+		return out, metadata, fmt.Errorf("unknown transport type %T", in.Request)
+	}
+
+	creds := cybrmiddleware.GetSigningCredentials(ctx)
+	if len(creds.SessionToken) == 0 {
+		return next.HandleFinalize(ctx, in)
+	}
+
+	if m.options.ClientLogMode.IsSigning() {
+		logSigning(ctx, creds)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+creds.SessionToken)
+	return next.HandleFinalize(ctx, in)
+}
+
+// logSigning logs the source and expiry of the credentials a request is
+// signed with. The credential values are never logged.
+func logSigning(ctx context.Context, creds cybr.Credentials) {
+	logger := middleware.GetLogger(ctx)
+
+	source := creds.Source
+	if len(source) == 0 {
+		source = "unknown"
+	}
+
+	if !creds.CanExpire {
+		logger.Logf(logging.Debug, "signing request with credentials from %s, credentials do not expire", source)
+		return
+	}
+	logger.Logf(logging.Debug, "signing request with credentials from %s, credentials expire at %s, expired: %t",
+		source, creds.Expires.UTC().Format(time.RFC3339), creds.Expired())
+}
+
+func requiresAuth(o Options, operation string) bool {
+	if noAuthOperations[operation] || o.Credentials == nil {
+		return false
+	}
+	_, anonymous := o.Credentials.(cybr.AnonymousCredentials)
+	return !anonymous
+}
+
+func addGetIdentityMiddleware(stack *middleware.Stack, o Options, operation string) error {
+	return stack.Finalize.Insert(&getIdentityMiddleware{options: o, operation: operation}, "ResolveEndpointV2", middleware.After)
+}
+
+func addSignRequestMiddleware(stack *middleware.Stack, o Options) error {
+	return stack.Finalize.Insert(&signRequestMiddleware{options: o}, "GetIdentity", middleware.After)
+}
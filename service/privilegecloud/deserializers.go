@@ -0,0 +1,9171 @@
+// Code generated by internal/codegen DO NOT EDIT.
+
+package privilegecloud
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+
+	cybrjson "github.com/strick-j/cybr-sdk-alpha/cybr/protocol/json"
+	"github.com/strick-j/cybr-sdk-alpha/service/privilegecloud/types"
+	smithy "github.com/strick-j/smithy-go"
+	smithyio "github.com/strick-j/smithy-go/io"
+	"github.com/strick-j/smithy-go/middleware"
+	"github.com/strick-j/smithy-go/ptr"
+	smithytime "github.com/strick-j/smithy-go/time"
+	smithyhttp "github.com/strick-j/smithy-go/transport/http"
+)
+
+type cybrRestjson_deserializeOpActivateTargetPlatform struct {
+}
+
+func (*cybrRestjson_deserializeOpActivateTargetPlatform) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpActivateTargetPlatform) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorActivateTargetPlatform(response, &metadata)
+	}
+	output := &ActivateTargetPlatformOutput{}
+	out.Result = output
+
+	if _, err = io.Copy(io.Discard, response.Body); err != nil {
+		return out, metadata, &smithy.DeserializationError{
+			Err: fmt.Errorf("failed to discard response body, %w", err),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorActivateTargetPlatform(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 404:
+		return cybrRestjson_deserializeErrorResourceNotFoundException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+type cybrRestjson_deserializeOpAdHocConnect struct {
+}
+
+func (*cybrRestjson_deserializeOpAdHocConnect) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpAdHocConnect) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorAdHocConnect(response, &metadata)
+	}
+	output := &AdHocConnectOutput{}
+	out.Result = output
+
+	if mediaType, _, _ := mime.ParseMediaType(response.Header.Get("Content-Type")); mediaType != "application/json" {
+		output.RDPFile, err = io.ReadAll(response.Body)
+		if err != nil {
+			return out, metadata, &smithy.DeserializationError{
+				Err: fmt.Errorf("failed to read response body, %w", err),
+			}
+		}
+		return out, metadata, nil
+	}
+
+	var buff [1024]byte
+	ringBuffer := smithyio.NewRingBuffer(buff[:])
+
+	body := io.TeeReader(response.Body, ringBuffer)
+
+	decoder := json.NewDecoder(body)
+	decoder.UseNumber()
+	var shape interface{}
+	if err := decoder.Decode(&shape); err != nil && err != io.EOF {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		err = &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+		return out, metadata, err
+	}
+
+	err = cybrRestjson_deserializeOpDocumentAdHocConnectOutput(&output, shape)
+	if err != nil {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		return out, metadata, &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorAdHocConnect(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch errorCode {
+	case "ITATS542I":
+		return cybrRestjson_deserializeErrorRequiresReasonException(errorCode, errorMessage)
+	case "ITATS546E":
+		return cybrRestjson_deserializeErrorRequiresTicketException(errorCode, errorMessage)
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+func cybrRestjson_deserializeOpDocumentAdHocConnectOutput(v **AdHocConnectOutput, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *AdHocConnectOutput
+	if *v == nil {
+		sv = &AdHocConnectOutput{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "PSMGWRequest":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.PSMGWRequest = ptr.String(jtv)
+			}
+
+		case "PSMGWURL":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.PSMGWURL = ptr.String(jtv)
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+type cybrRestjson_deserializeOpAddAccount struct {
+}
+
+func (*cybrRestjson_deserializeOpAddAccount) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpAddAccount) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorAddAccount(response, &metadata)
+	}
+	output := &AddAccountOutput{}
+	out.Result = output
+
+	var buff [1024]byte
+	ringBuffer := smithyio.NewRingBuffer(buff[:])
+
+	body := io.TeeReader(response.Body, ringBuffer)
+
+	decoder := json.NewDecoder(body)
+	decoder.UseNumber()
+	var shape interface{}
+	if err := decoder.Decode(&shape); err != nil && err != io.EOF {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		err = &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+		return out, metadata, err
+	}
+
+	err = cybrRestjson_deserializeOpDocumentAddAccountOutput(&output, shape)
+	if err != nil {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		return out, metadata, &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorAddAccount(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 404:
+		return cybrRestjson_deserializeErrorResourceNotFoundException(errorCode, errorMessage)
+	case 409:
+		return cybrRestjson_deserializeErrorConflictException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+func cybrRestjson_deserializeOpDocumentAddAccountOutput(v **AddAccountOutput, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *AddAccountOutput
+	if *v == nil {
+		sv = &AddAccountOutput{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "address":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.Address = ptr.String(jtv)
+			}
+
+		case "categoryModificationTime":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected EpochTime to be json.Number, got %T instead", value)
+				}
+				f64, err := jtv.Float64()
+				if err != nil {
+					return err
+				}
+				sv.CategoryModificationTime = ptr.Time(smithytime.ParseEpochSeconds(f64))
+			}
+
+		case "createdTime":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected EpochTime to be json.Number, got %T instead", value)
+				}
+				f64, err := jtv.Float64()
+				if err != nil {
+					return err
+				}
+				sv.CreatedTime = ptr.Time(smithytime.ParseEpochSeconds(f64))
+			}
+
+		case "id":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.Id = ptr.String(jtv)
+			}
+
+		case "name":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.Name = ptr.String(jtv)
+			}
+
+		case "platformAccountProperties":
+			if err := cybrRestjson_deserializeDocumentStringMap(&sv.PlatformAccountProperties, value); err != nil {
+				return err
+			}
+
+		case "platformId":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.PlatformId = ptr.String(jtv)
+			}
+
+		case "remoteMachinesAccess":
+			if err := cybrRestjson_deserializeDocumentRemoteMachinesAccess(&sv.RemoteMachinesAccess, value); err != nil {
+				return err
+			}
+
+		case "safeName":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.SafeName = ptr.String(jtv)
+			}
+
+		case "secretManagement":
+			if err := cybrRestjson_deserializeDocumentSecretManagement(&sv.SecretManagement, value); err != nil {
+				return err
+			}
+
+		case "secretType":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected SecretType to be of type string, got %T instead", value)
+				}
+				sv.SecretType = types.SecretType(jtv)
+			}
+
+		case "userName":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.UserName = ptr.String(jtv)
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+type cybrRestjson_deserializeOpChangeCredentials struct {
+}
+
+func (*cybrRestjson_deserializeOpChangeCredentials) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpChangeCredentials) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorChangeCredentials(response, &metadata)
+	}
+	output := &ChangeCredentialsOutput{}
+	out.Result = output
+
+	if _, err = io.Copy(io.Discard, response.Body); err != nil {
+		return out, metadata, &smithy.DeserializationError{
+			Err: fmt.Errorf("failed to discard response body, %w", err),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorChangeCredentials(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 404:
+		return cybrRestjson_deserializeErrorResourceNotFoundException(errorCode, errorMessage)
+	case 409:
+		return cybrRestjson_deserializeErrorConflictException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+type cybrRestjson_deserializeOpConfirmRequest struct {
+}
+
+func (*cybrRestjson_deserializeOpConfirmRequest) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpConfirmRequest) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorConfirmRequest(response, &metadata)
+	}
+	output := &ConfirmRequestOutput{}
+	out.Result = output
+
+	if _, err = io.Copy(io.Discard, response.Body); err != nil {
+		return out, metadata, &smithy.DeserializationError{
+			Err: fmt.Errorf("failed to discard response body, %w", err),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorConfirmRequest(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 404:
+		return cybrRestjson_deserializeErrorResourceNotFoundException(errorCode, errorMessage)
+	case 409:
+		return cybrRestjson_deserializeErrorConflictException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+type cybrRestjson_deserializeOpConnectAccount struct {
+}
+
+func (*cybrRestjson_deserializeOpConnectAccount) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpConnectAccount) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorConnectAccount(response, &metadata)
+	}
+	output := &ConnectAccountOutput{}
+	out.Result = output
+
+	if mediaType, _, _ := mime.ParseMediaType(response.Header.Get("Content-Type")); mediaType != "application/json" {
+		output.RDPFile, err = io.ReadAll(response.Body)
+		if err != nil {
+			return out, metadata, &smithy.DeserializationError{
+				Err: fmt.Errorf("failed to read response body, %w", err),
+			}
+		}
+		return out, metadata, nil
+	}
+
+	var buff [1024]byte
+	ringBuffer := smithyio.NewRingBuffer(buff[:])
+
+	body := io.TeeReader(response.Body, ringBuffer)
+
+	decoder := json.NewDecoder(body)
+	decoder.UseNumber()
+	var shape interface{}
+	if err := decoder.Decode(&shape); err != nil && err != io.EOF {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		err = &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+		return out, metadata, err
+	}
+
+	err = cybrRestjson_deserializeOpDocumentConnectAccountOutput(&output, shape)
+	if err != nil {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		return out, metadata, &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorConnectAccount(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch errorCode {
+	case "ITATS542I":
+		return cybrRestjson_deserializeErrorRequiresReasonException(errorCode, errorMessage)
+	case "ITATS546E":
+		return cybrRestjson_deserializeErrorRequiresTicketException(errorCode, errorMessage)
+	case "ITATS543I", "ITATS544I":
+		return cybrRestjson_deserializeErrorRequiresConfirmationException(errorCode, errorMessage)
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 404:
+		return cybrRestjson_deserializeErrorResourceNotFoundException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+func cybrRestjson_deserializeOpDocumentConnectAccountOutput(v **ConnectAccountOutput, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *ConnectAccountOutput
+	if *v == nil {
+		sv = &ConnectAccountOutput{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "PSMGWRequest":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.PSMGWRequest = ptr.String(jtv)
+			}
+
+		case "PSMGWURL":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.PSMGWURL = ptr.String(jtv)
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+type cybrRestjson_deserializeOpCreateRequest struct {
+}
+
+func (*cybrRestjson_deserializeOpCreateRequest) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpCreateRequest) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorCreateRequest(response, &metadata)
+	}
+	output := &CreateRequestOutput{}
+	out.Result = output
+
+	var buff [1024]byte
+	ringBuffer := smithyio.NewRingBuffer(buff[:])
+
+	body := io.TeeReader(response.Body, ringBuffer)
+
+	decoder := json.NewDecoder(body)
+	decoder.UseNumber()
+	var shape interface{}
+	if err := decoder.Decode(&shape); err != nil && err != io.EOF {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		err = &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+		return out, metadata, err
+	}
+
+	err = cybrRestjson_deserializeOpDocumentCreateRequestOutput(&output, shape)
+	if err != nil {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		return out, metadata, &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorCreateRequest(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch errorCode {
+	case "ITATS542I":
+		return cybrRestjson_deserializeErrorRequiresReasonException(errorCode, errorMessage)
+	case "ITATS546E":
+		return cybrRestjson_deserializeErrorRequiresTicketException(errorCode, errorMessage)
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 404:
+		return cybrRestjson_deserializeErrorResourceNotFoundException(errorCode, errorMessage)
+	case 409:
+		return cybrRestjson_deserializeErrorConflictException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+func cybrRestjson_deserializeOpDocumentCreateRequestOutput(v **CreateRequestOutput, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *CreateRequestOutput
+	if *v == nil {
+		sv = &CreateRequestOutput{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "AccessFrom":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected EpochTime to be json.Number, got %T instead", value)
+				}
+				f64, err := jtv.Float64()
+				if err != nil {
+					return err
+				}
+				sv.AccessFrom = ptr.Time(smithytime.ParseEpochSeconds(f64))
+			}
+
+		case "AccessTo":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected EpochTime to be json.Number, got %T instead", value)
+				}
+				f64, err := jtv.Float64()
+				if err != nil {
+					return err
+				}
+				sv.AccessTo = ptr.Time(smithytime.ParseEpochSeconds(f64))
+			}
+
+		case "AccessType":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected AccessType to be of type string, got %T instead", value)
+				}
+				sv.AccessType = types.AccessType(jtv)
+			}
+
+		case "AccountId":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.AccountId = ptr.String(jtv)
+			}
+
+		case "ConfirmationsLeft":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected Integer to be json.Number, got %T instead", value)
+				}
+				i64, err := jtv.Int64()
+				if err != nil {
+					return err
+				}
+				sv.ConfirmationsLeft = ptr.Int32(int32(i64))
+			}
+
+		case "CreationDate":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected EpochTime to be json.Number, got %T instead", value)
+				}
+				f64, err := jtv.Float64()
+				if err != nil {
+					return err
+				}
+				sv.CreationDate = ptr.Time(smithytime.ParseEpochSeconds(f64))
+			}
+
+		case "ExpirationDate":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected EpochTime to be json.Number, got %T instead", value)
+				}
+				f64, err := jtv.Float64()
+				if err != nil {
+					return err
+				}
+				sv.ExpirationDate = ptr.Time(smithytime.ParseEpochSeconds(f64))
+			}
+
+		case "MultipleAccessRequired":
+			if value != nil {
+				jtv, ok := value.(bool)
+				if !ok {
+					return fmt.Errorf("expected Boolean to be of type *bool, got %T instead", value)
+				}
+				sv.MultipleAccessRequired = ptr.Bool(jtv)
+			}
+
+		case "Operation":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.Operation = ptr.String(jtv)
+			}
+
+		case "RequestID":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.RequestId = ptr.String(jtv)
+			}
+
+		case "RequestorReason":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.RequestorReason = ptr.String(jtv)
+			}
+
+		case "RequestorUserName":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.RequestorUserName = ptr.String(jtv)
+			}
+
+		case "SafeName":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.SafeName = ptr.String(jtv)
+			}
+
+		case "Status":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected Integer to be json.Number, got %T instead", value)
+				}
+				i64, err := jtv.Int64()
+				if err != nil {
+					return err
+				}
+				sv.Status = ptr.Int32(int32(i64))
+			}
+
+		case "StatusTitle":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected AccessRequestStatus to be of type string, got %T instead", value)
+				}
+				sv.StatusTitle = types.AccessRequestStatus(jtv)
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+type cybrRestjson_deserializeOpCreateSafe struct {
+}
+
+func (*cybrRestjson_deserializeOpCreateSafe) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpCreateSafe) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorCreateSafe(response, &metadata)
+	}
+	output := &CreateSafeOutput{}
+	out.Result = output
+
+	var buff [1024]byte
+	ringBuffer := smithyio.NewRingBuffer(buff[:])
+
+	body := io.TeeReader(response.Body, ringBuffer)
+
+	decoder := json.NewDecoder(body)
+	decoder.UseNumber()
+	var shape interface{}
+	if err := decoder.Decode(&shape); err != nil && err != io.EOF {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		err = &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+		return out, metadata, err
+	}
+
+	err = cybrRestjson_deserializeOpDocumentCreateSafeOutput(&output, shape)
+	if err != nil {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		return out, metadata, &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorCreateSafe(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 409:
+		return cybrRestjson_deserializeErrorSafeAlreadyExistsException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+func cybrRestjson_deserializeOpDocumentCreateSafeOutput(v **CreateSafeOutput, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *CreateSafeOutput
+	if *v == nil {
+		sv = &CreateSafeOutput{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "autoPurgeEnabled":
+			if value != nil {
+				jtv, ok := value.(bool)
+				if !ok {
+					return fmt.Errorf("expected Boolean to be of type *bool, got %T instead", value)
+				}
+				sv.AutoPurgeEnabled = ptr.Bool(jtv)
+			}
+
+		case "creationTime":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected EpochTime to be json.Number, got %T instead", value)
+				}
+				f64, err := jtv.Float64()
+				if err != nil {
+					return err
+				}
+				sv.CreationTime = ptr.Time(smithytime.ParseEpochSeconds(f64))
+			}
+
+		case "creator":
+			if err := cybrRestjson_deserializeDocumentSafeCreator(&sv.Creator, value); err != nil {
+				return err
+			}
+
+		case "description":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.Description = ptr.String(jtv)
+			}
+
+		case "isExpiredMember":
+			if value != nil {
+				jtv, ok := value.(bool)
+				if !ok {
+					return fmt.Errorf("expected Boolean to be of type *bool, got %T instead", value)
+				}
+				sv.IsExpiredMember = ptr.Bool(jtv)
+			}
+
+		case "lastModificationTime":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected Long to be json.Number, got %T instead", value)
+				}
+				i64, err := jtv.Int64()
+				if err != nil {
+					return err
+				}
+				sv.LastModificationTime = ptr.Int64(i64)
+			}
+
+		case "location":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.Location = ptr.String(jtv)
+			}
+
+		case "managingCPM":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.ManagingCPM = ptr.String(jtv)
+			}
+
+		case "numberOfDaysRetention":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected Integer to be json.Number, got %T instead", value)
+				}
+				i64, err := jtv.Int64()
+				if err != nil {
+					return err
+				}
+				sv.NumberOfDaysRetention = ptr.Int32(int32(i64))
+			}
+
+		case "numberOfVersionsRetention":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected Integer to be json.Number, got %T instead", value)
+				}
+				i64, err := jtv.Int64()
+				if err != nil {
+					return err
+				}
+				sv.NumberOfVersionsRetention = ptr.Int32(int32(i64))
+			}
+
+		case "olacEnabled":
+			if value != nil {
+				jtv, ok := value.(bool)
+				if !ok {
+					return fmt.Errorf("expected Boolean to be of type *bool, got %T instead", value)
+				}
+				sv.OlacEnabled = ptr.Bool(jtv)
+			}
+
+		case "safeName":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.SafeName = ptr.String(jtv)
+			}
+
+		case "safeNumber":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected Integer to be json.Number, got %T instead", value)
+				}
+				i64, err := jtv.Int64()
+				if err != nil {
+					return err
+				}
+				sv.SafeNumber = ptr.Int32(int32(i64))
+			}
+
+		case "safeUrlId":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.SafeUrlId = ptr.String(jtv)
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+type cybrRestjson_deserializeOpDeactivateTargetPlatform struct {
+}
+
+func (*cybrRestjson_deserializeOpDeactivateTargetPlatform) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpDeactivateTargetPlatform) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorDeactivateTargetPlatform(response, &metadata)
+	}
+	output := &DeactivateTargetPlatformOutput{}
+	out.Result = output
+
+	if _, err = io.Copy(io.Discard, response.Body); err != nil {
+		return out, metadata, &smithy.DeserializationError{
+			Err: fmt.Errorf("failed to discard response body, %w", err),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorDeactivateTargetPlatform(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 404:
+		return cybrRestjson_deserializeErrorResourceNotFoundException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+type cybrRestjson_deserializeOpDeleteAccount struct {
+}
+
+func (*cybrRestjson_deserializeOpDeleteAccount) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpDeleteAccount) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorDeleteAccount(response, &metadata)
+	}
+	output := &DeleteAccountOutput{}
+	out.Result = output
+
+	if _, err = io.Copy(io.Discard, response.Body); err != nil {
+		return out, metadata, &smithy.DeserializationError{
+			Err: fmt.Errorf("failed to discard response body, %w", err),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorDeleteAccount(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 404:
+		return cybrRestjson_deserializeErrorResourceNotFoundException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+type cybrRestjson_deserializeOpDeleteDependentPlatform struct {
+}
+
+func (*cybrRestjson_deserializeOpDeleteDependentPlatform) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpDeleteDependentPlatform) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorDeleteDependentPlatform(response, &metadata)
+	}
+	output := &DeleteDependentPlatformOutput{}
+	out.Result = output
+
+	if _, err = io.Copy(io.Discard, response.Body); err != nil {
+		return out, metadata, &smithy.DeserializationError{
+			Err: fmt.Errorf("failed to discard response body, %w", err),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorDeleteDependentPlatform(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 404:
+		return cybrRestjson_deserializeErrorResourceNotFoundException(errorCode, errorMessage)
+	case 409:
+		return cybrRestjson_deserializeErrorConflictException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+type cybrRestjson_deserializeOpDeleteGroupPlatform struct {
+}
+
+func (*cybrRestjson_deserializeOpDeleteGroupPlatform) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpDeleteGroupPlatform) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorDeleteGroupPlatform(response, &metadata)
+	}
+	output := &DeleteGroupPlatformOutput{}
+	out.Result = output
+
+	if _, err = io.Copy(io.Discard, response.Body); err != nil {
+		return out, metadata, &smithy.DeserializationError{
+			Err: fmt.Errorf("failed to discard response body, %w", err),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorDeleteGroupPlatform(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 404:
+		return cybrRestjson_deserializeErrorResourceNotFoundException(errorCode, errorMessage)
+	case 409:
+		return cybrRestjson_deserializeErrorConflictException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+type cybrRestjson_deserializeOpDeleteMFACachingSSHKey struct {
+}
+
+func (*cybrRestjson_deserializeOpDeleteMFACachingSSHKey) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpDeleteMFACachingSSHKey) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorDeleteMFACachingSSHKey(response, &metadata)
+	}
+	output := &DeleteMFACachingSSHKeyOutput{}
+	out.Result = output
+
+	if _, err = io.Copy(io.Discard, response.Body); err != nil {
+		return out, metadata, &smithy.DeserializationError{
+			Err: fmt.Errorf("failed to discard response body, %w", err),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorDeleteMFACachingSSHKey(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 404:
+		return cybrRestjson_deserializeErrorResourceNotFoundException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+type cybrRestjson_deserializeOpDeleteRequest struct {
+}
+
+func (*cybrRestjson_deserializeOpDeleteRequest) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpDeleteRequest) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorDeleteRequest(response, &metadata)
+	}
+	output := &DeleteRequestOutput{}
+	out.Result = output
+
+	if _, err = io.Copy(io.Discard, response.Body); err != nil {
+		return out, metadata, &smithy.DeserializationError{
+			Err: fmt.Errorf("failed to discard response body, %w", err),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorDeleteRequest(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 404:
+		return cybrRestjson_deserializeErrorResourceNotFoundException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+type cybrRestjson_deserializeOpDeleteRotationalGroupPlatform struct {
+}
+
+func (*cybrRestjson_deserializeOpDeleteRotationalGroupPlatform) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpDeleteRotationalGroupPlatform) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorDeleteRotationalGroupPlatform(response, &metadata)
+	}
+	output := &DeleteRotationalGroupPlatformOutput{}
+	out.Result = output
+
+	if _, err = io.Copy(io.Discard, response.Body); err != nil {
+		return out, metadata, &smithy.DeserializationError{
+			Err: fmt.Errorf("failed to discard response body, %w", err),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorDeleteRotationalGroupPlatform(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 404:
+		return cybrRestjson_deserializeErrorResourceNotFoundException(errorCode, errorMessage)
+	case 409:
+		return cybrRestjson_deserializeErrorConflictException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+type cybrRestjson_deserializeOpDeleteSafe struct {
+}
+
+func (*cybrRestjson_deserializeOpDeleteSafe) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpDeleteSafe) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorDeleteSafe(response, &metadata)
+	}
+	output := &DeleteSafeOutput{}
+	out.Result = output
+
+	if _, err = io.Copy(io.Discard, response.Body); err != nil {
+		return out, metadata, &smithy.DeserializationError{
+			Err: fmt.Errorf("failed to discard response body, %w", err),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorDeleteSafe(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch errorCode {
+	case "SFWS0010E":
+		return cybrRestjson_deserializeErrorSafeRetentionPeriodException(errorCode, errorMessage)
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 404:
+		return cybrRestjson_deserializeErrorSafeNotFoundException(errorCode, errorMessage)
+	case 409:
+		return cybrRestjson_deserializeErrorConflictException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+type cybrRestjson_deserializeOpDeleteTargetPlatform struct {
+}
+
+func (*cybrRestjson_deserializeOpDeleteTargetPlatform) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpDeleteTargetPlatform) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorDeleteTargetPlatform(response, &metadata)
+	}
+	output := &DeleteTargetPlatformOutput{}
+	out.Result = output
+
+	if _, err = io.Copy(io.Discard, response.Body); err != nil {
+		return out, metadata, &smithy.DeserializationError{
+			Err: fmt.Errorf("failed to discard response body, %w", err),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorDeleteTargetPlatform(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 404:
+		return cybrRestjson_deserializeErrorResourceNotFoundException(errorCode, errorMessage)
+	case 409:
+		return cybrRestjson_deserializeErrorConflictException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+type cybrRestjson_deserializeOpDownloadRecording struct {
+}
+
+func (*cybrRestjson_deserializeOpDownloadRecording) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpDownloadRecording) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorDownloadRecording(response, &metadata)
+	}
+	output := &DownloadRecordingOutput{}
+	out.Result = output
+
+	output.Body = response.Body
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorDownloadRecording(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 404:
+		return cybrRestjson_deserializeErrorResourceNotFoundException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+type cybrRestjson_deserializeOpDuplicateDependentPlatform struct {
+}
+
+func (*cybrRestjson_deserializeOpDuplicateDependentPlatform) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpDuplicateDependentPlatform) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorDuplicateDependentPlatform(response, &metadata)
+	}
+	output := &DuplicateDependentPlatformOutput{}
+	out.Result = output
+
+	var buff [1024]byte
+	ringBuffer := smithyio.NewRingBuffer(buff[:])
+
+	body := io.TeeReader(response.Body, ringBuffer)
+
+	decoder := json.NewDecoder(body)
+	decoder.UseNumber()
+	var shape interface{}
+	if err := decoder.Decode(&shape); err != nil && err != io.EOF {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		err = &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+		return out, metadata, err
+	}
+
+	err = cybrRestjson_deserializeOpDocumentDuplicateDependentPlatformOutput(&output, shape)
+	if err != nil {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		return out, metadata, &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorDuplicateDependentPlatform(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 404:
+		return cybrRestjson_deserializeErrorResourceNotFoundException(errorCode, errorMessage)
+	case 409:
+		return cybrRestjson_deserializeErrorConflictException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+func cybrRestjson_deserializeOpDocumentDuplicateDependentPlatformOutput(v **DuplicateDependentPlatformOutput, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *DuplicateDependentPlatformOutput
+	if *v == nil {
+		sv = &DuplicateDependentPlatformOutput{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "Description":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.Description = ptr.String(jtv)
+			}
+
+		case "ID":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected Integer to be json.Number, got %T instead", value)
+				}
+				i64, err := jtv.Int64()
+				if err != nil {
+					return err
+				}
+				sv.Id = ptr.Int32(int32(i64))
+			}
+
+		case "Name":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.Name = ptr.String(jtv)
+			}
+
+		case "PlatformID":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.PlatformId = ptr.String(jtv)
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+type cybrRestjson_deserializeOpDuplicateGroupPlatform struct {
+}
+
+func (*cybrRestjson_deserializeOpDuplicateGroupPlatform) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpDuplicateGroupPlatform) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorDuplicateGroupPlatform(response, &metadata)
+	}
+	output := &DuplicateGroupPlatformOutput{}
+	out.Result = output
+
+	var buff [1024]byte
+	ringBuffer := smithyio.NewRingBuffer(buff[:])
+
+	body := io.TeeReader(response.Body, ringBuffer)
+
+	decoder := json.NewDecoder(body)
+	decoder.UseNumber()
+	var shape interface{}
+	if err := decoder.Decode(&shape); err != nil && err != io.EOF {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		err = &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+		return out, metadata, err
+	}
+
+	err = cybrRestjson_deserializeOpDocumentDuplicateGroupPlatformOutput(&output, shape)
+	if err != nil {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		return out, metadata, &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorDuplicateGroupPlatform(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 404:
+		return cybrRestjson_deserializeErrorResourceNotFoundException(errorCode, errorMessage)
+	case 409:
+		return cybrRestjson_deserializeErrorConflictException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+func cybrRestjson_deserializeOpDocumentDuplicateGroupPlatformOutput(v **DuplicateGroupPlatformOutput, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *DuplicateGroupPlatformOutput
+	if *v == nil {
+		sv = &DuplicateGroupPlatformOutput{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "Description":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.Description = ptr.String(jtv)
+			}
+
+		case "ID":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected Integer to be json.Number, got %T instead", value)
+				}
+				i64, err := jtv.Int64()
+				if err != nil {
+					return err
+				}
+				sv.Id = ptr.Int32(int32(i64))
+			}
+
+		case "Name":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.Name = ptr.String(jtv)
+			}
+
+		case "PlatformID":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.PlatformId = ptr.String(jtv)
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+type cybrRestjson_deserializeOpDuplicateRotationalGroupPlatform struct {
+}
+
+func (*cybrRestjson_deserializeOpDuplicateRotationalGroupPlatform) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpDuplicateRotationalGroupPlatform) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorDuplicateRotationalGroupPlatform(response, &metadata)
+	}
+	output := &DuplicateRotationalGroupPlatformOutput{}
+	out.Result = output
+
+	var buff [1024]byte
+	ringBuffer := smithyio.NewRingBuffer(buff[:])
+
+	body := io.TeeReader(response.Body, ringBuffer)
+
+	decoder := json.NewDecoder(body)
+	decoder.UseNumber()
+	var shape interface{}
+	if err := decoder.Decode(&shape); err != nil && err != io.EOF {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		err = &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+		return out, metadata, err
+	}
+
+	err = cybrRestjson_deserializeOpDocumentDuplicateRotationalGroupPlatformOutput(&output, shape)
+	if err != nil {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		return out, metadata, &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorDuplicateRotationalGroupPlatform(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 404:
+		return cybrRestjson_deserializeErrorResourceNotFoundException(errorCode, errorMessage)
+	case 409:
+		return cybrRestjson_deserializeErrorConflictException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+func cybrRestjson_deserializeOpDocumentDuplicateRotationalGroupPlatformOutput(v **DuplicateRotationalGroupPlatformOutput, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *DuplicateRotationalGroupPlatformOutput
+	if *v == nil {
+		sv = &DuplicateRotationalGroupPlatformOutput{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "Description":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.Description = ptr.String(jtv)
+			}
+
+		case "ID":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected Integer to be json.Number, got %T instead", value)
+				}
+				i64, err := jtv.Int64()
+				if err != nil {
+					return err
+				}
+				sv.Id = ptr.Int32(int32(i64))
+			}
+
+		case "Name":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.Name = ptr.String(jtv)
+			}
+
+		case "PlatformID":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.PlatformId = ptr.String(jtv)
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+type cybrRestjson_deserializeOpDuplicateTargetPlatform struct {
+}
+
+func (*cybrRestjson_deserializeOpDuplicateTargetPlatform) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpDuplicateTargetPlatform) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorDuplicateTargetPlatform(response, &metadata)
+	}
+	output := &DuplicateTargetPlatformOutput{}
+	out.Result = output
+
+	var buff [1024]byte
+	ringBuffer := smithyio.NewRingBuffer(buff[:])
+
+	body := io.TeeReader(response.Body, ringBuffer)
+
+	decoder := json.NewDecoder(body)
+	decoder.UseNumber()
+	var shape interface{}
+	if err := decoder.Decode(&shape); err != nil && err != io.EOF {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		err = &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+		return out, metadata, err
+	}
+
+	err = cybrRestjson_deserializeOpDocumentDuplicateTargetPlatformOutput(&output, shape)
+	if err != nil {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		return out, metadata, &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorDuplicateTargetPlatform(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 404:
+		return cybrRestjson_deserializeErrorResourceNotFoundException(errorCode, errorMessage)
+	case 409:
+		return cybrRestjson_deserializeErrorConflictException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+func cybrRestjson_deserializeOpDocumentDuplicateTargetPlatformOutput(v **DuplicateTargetPlatformOutput, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *DuplicateTargetPlatformOutput
+	if *v == nil {
+		sv = &DuplicateTargetPlatformOutput{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "Description":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.Description = ptr.String(jtv)
+			}
+
+		case "ID":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected Integer to be json.Number, got %T instead", value)
+				}
+				i64, err := jtv.Int64()
+				if err != nil {
+					return err
+				}
+				sv.Id = ptr.Int32(int32(i64))
+			}
+
+		case "Name":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.Name = ptr.String(jtv)
+			}
+
+		case "PlatformID":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.PlatformId = ptr.String(jtv)
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+type cybrRestjson_deserializeOpExportPlatform struct {
+}
+
+func (*cybrRestjson_deserializeOpExportPlatform) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpExportPlatform) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorExportPlatform(response, &metadata)
+	}
+	output := &ExportPlatformOutput{}
+	out.Result = output
+
+	output.Body = response.Body
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorExportPlatform(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 404:
+		return cybrRestjson_deserializeErrorResourceNotFoundException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+type cybrRestjson_deserializeOpGenerateMFACachingSSHKey struct {
+}
+
+func (*cybrRestjson_deserializeOpGenerateMFACachingSSHKey) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpGenerateMFACachingSSHKey) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorGenerateMFACachingSSHKey(response, &metadata)
+	}
+	output := &GenerateMFACachingSSHKeyOutput{}
+	out.Result = output
+
+	var buff [1024]byte
+	ringBuffer := smithyio.NewRingBuffer(buff[:])
+
+	body := io.TeeReader(response.Body, ringBuffer)
+
+	decoder := json.NewDecoder(body)
+	decoder.UseNumber()
+	var shape interface{}
+	if err := decoder.Decode(&shape); err != nil && err != io.EOF {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		err = &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+		return out, metadata, err
+	}
+
+	err = cybrRestjson_deserializeOpDocumentGenerateMFACachingSSHKeyOutput(&output, shape)
+	if err != nil {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		return out, metadata, &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorGenerateMFACachingSSHKey(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+func cybrRestjson_deserializeOpDocumentGenerateMFACachingSSHKeyOutput(v **GenerateMFACachingSSHKeyOutput, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *GenerateMFACachingSSHKeyOutput
+	if *v == nil {
+		sv = &GenerateMFACachingSSHKeyOutput{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "count":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected Integer to be json.Number, got %T instead", value)
+				}
+				i64, err := jtv.Int64()
+				if err != nil {
+					return err
+				}
+				sv.Count = ptr.Int32(int32(i64))
+			}
+
+		case "expirationTime":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected EpochTime to be json.Number, got %T instead", value)
+				}
+				f64, err := jtv.Float64()
+				if err != nil {
+					return err
+				}
+				sv.ExpirationTime = ptr.Time(smithytime.ParseEpochSeconds(f64))
+			}
+
+		case "value":
+			if err := cybrRestjson_deserializeDocumentPrivateSSHKeyList(&sv.PrivateKeys, value); err != nil {
+				return err
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+type cybrRestjson_deserializeOpGetAccount struct {
+}
+
+func (*cybrRestjson_deserializeOpGetAccount) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpGetAccount) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorGetAccount(response, &metadata)
+	}
+	output := &GetAccountOutput{}
+	out.Result = output
+
+	var buff [1024]byte
+	ringBuffer := smithyio.NewRingBuffer(buff[:])
+
+	body := io.TeeReader(response.Body, ringBuffer)
+
+	decoder := json.NewDecoder(body)
+	decoder.UseNumber()
+	var shape interface{}
+	if err := decoder.Decode(&shape); err != nil && err != io.EOF {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		err = &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+		return out, metadata, err
+	}
+
+	err = cybrRestjson_deserializeOpDocumentGetAccountOutput(&output, shape)
+	if err != nil {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		return out, metadata, &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorGetAccount(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 404:
+		return cybrRestjson_deserializeErrorResourceNotFoundException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+func cybrRestjson_deserializeOpDocumentGetAccountOutput(v **GetAccountOutput, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *GetAccountOutput
+	if *v == nil {
+		sv = &GetAccountOutput{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "address":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.Address = ptr.String(jtv)
+			}
+
+		case "categoryModificationTime":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected EpochTime to be json.Number, got %T instead", value)
+				}
+				f64, err := jtv.Float64()
+				if err != nil {
+					return err
+				}
+				sv.CategoryModificationTime = ptr.Time(smithytime.ParseEpochSeconds(f64))
+			}
+
+		case "createdTime":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected EpochTime to be json.Number, got %T instead", value)
+				}
+				f64, err := jtv.Float64()
+				if err != nil {
+					return err
+				}
+				sv.CreatedTime = ptr.Time(smithytime.ParseEpochSeconds(f64))
+			}
+
+		case "id":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.Id = ptr.String(jtv)
+			}
+
+		case "name":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.Name = ptr.String(jtv)
+			}
+
+		case "platformAccountProperties":
+			if err := cybrRestjson_deserializeDocumentStringMap(&sv.PlatformAccountProperties, value); err != nil {
+				return err
+			}
+
+		case "platformId":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.PlatformId = ptr.String(jtv)
+			}
+
+		case "remoteMachinesAccess":
+			if err := cybrRestjson_deserializeDocumentRemoteMachinesAccess(&sv.RemoteMachinesAccess, value); err != nil {
+				return err
+			}
+
+		case "safeName":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.SafeName = ptr.String(jtv)
+			}
+
+		case "secretManagement":
+			if err := cybrRestjson_deserializeDocumentSecretManagement(&sv.SecretManagement, value); err != nil {
+				return err
+			}
+
+		case "secretType":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected SecretType to be of type string, got %T instead", value)
+				}
+				sv.SecretType = types.SecretType(jtv)
+			}
+
+		case "userName":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.UserName = ptr.String(jtv)
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+type cybrRestjson_deserializeOpGetMFACachingSSHKey struct {
+}
+
+func (*cybrRestjson_deserializeOpGetMFACachingSSHKey) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpGetMFACachingSSHKey) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorGetMFACachingSSHKey(response, &metadata)
+	}
+	output := &GetMFACachingSSHKeyOutput{}
+	out.Result = output
+
+	var buff [1024]byte
+	ringBuffer := smithyio.NewRingBuffer(buff[:])
+
+	body := io.TeeReader(response.Body, ringBuffer)
+
+	decoder := json.NewDecoder(body)
+	decoder.UseNumber()
+	var shape interface{}
+	if err := decoder.Decode(&shape); err != nil && err != io.EOF {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		err = &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+		return out, metadata, err
+	}
+
+	err = cybrRestjson_deserializeOpDocumentGetMFACachingSSHKeyOutput(&output, shape)
+	if err != nil {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		return out, metadata, &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorGetMFACachingSSHKey(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 404:
+		return cybrRestjson_deserializeErrorResourceNotFoundException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+func cybrRestjson_deserializeOpDocumentGetMFACachingSSHKeyOutput(v **GetMFACachingSSHKeyOutput, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *GetMFACachingSSHKeyOutput
+	if *v == nil {
+		sv = &GetMFACachingSSHKeyOutput{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "count":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected Integer to be json.Number, got %T instead", value)
+				}
+				i64, err := jtv.Int64()
+				if err != nil {
+					return err
+				}
+				sv.Count = ptr.Int32(int32(i64))
+			}
+
+		case "expirationTime":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected EpochTime to be json.Number, got %T instead", value)
+				}
+				f64, err := jtv.Float64()
+				if err != nil {
+					return err
+				}
+				sv.ExpirationTime = ptr.Time(smithytime.ParseEpochSeconds(f64))
+			}
+
+		case "value":
+			if err := cybrRestjson_deserializeDocumentPrivateSSHKeyList(&sv.PrivateKeys, value); err != nil {
+				return err
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+type cybrRestjson_deserializeOpGetRecordingActivities struct {
+}
+
+func (*cybrRestjson_deserializeOpGetRecordingActivities) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpGetRecordingActivities) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorGetRecordingActivities(response, &metadata)
+	}
+	output := &GetRecordingActivitiesOutput{}
+	out.Result = output
+
+	var buff [1024]byte
+	ringBuffer := smithyio.NewRingBuffer(buff[:])
+
+	body := io.TeeReader(response.Body, ringBuffer)
+
+	decoder := json.NewDecoder(body)
+	decoder.UseNumber()
+	var shape interface{}
+	if err := decoder.Decode(&shape); err != nil && err != io.EOF {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		err = &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+		return out, metadata, err
+	}
+
+	err = cybrRestjson_deserializeOpDocumentGetRecordingActivitiesOutput(&output, shape)
+	if err != nil {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		return out, metadata, &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorGetRecordingActivities(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 404:
+		return cybrRestjson_deserializeErrorResourceNotFoundException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+func cybrRestjson_deserializeOpDocumentGetRecordingActivitiesOutput(v **GetRecordingActivitiesOutput, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *GetRecordingActivitiesOutput
+	if *v == nil {
+		sv = &GetRecordingActivitiesOutput{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "Activities":
+			if err := cybrRestjson_deserializeDocumentRecordingActivityList(&sv.Activities, value); err != nil {
+				return err
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+type cybrRestjson_deserializeOpGetRecordingProperties struct {
+}
+
+func (*cybrRestjson_deserializeOpGetRecordingProperties) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpGetRecordingProperties) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorGetRecordingProperties(response, &metadata)
+	}
+	output := &GetRecordingPropertiesOutput{}
+	out.Result = output
+
+	var buff [1024]byte
+	ringBuffer := smithyio.NewRingBuffer(buff[:])
+
+	body := io.TeeReader(response.Body, ringBuffer)
+
+	decoder := json.NewDecoder(body)
+	decoder.UseNumber()
+	var shape interface{}
+	if err := decoder.Decode(&shape); err != nil && err != io.EOF {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		err = &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+		return out, metadata, err
+	}
+
+	err = cybrRestjson_deserializeOpDocumentGetRecordingPropertiesOutput(&output, shape)
+	if err != nil {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		return out, metadata, &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorGetRecordingProperties(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 404:
+		return cybrRestjson_deserializeErrorResourceNotFoundException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+func cybrRestjson_deserializeOpDocumentGetRecordingPropertiesOutput(v **GetRecordingPropertiesOutput, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *GetRecordingPropertiesOutput
+	if *v == nil {
+		sv = &GetRecordingPropertiesOutput{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "Properties":
+			if err := cybrRestjson_deserializeDocumentStringMap(&sv.Properties, value); err != nil {
+				return err
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+type cybrRestjson_deserializeOpGetRequest struct {
+}
+
+func (*cybrRestjson_deserializeOpGetRequest) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpGetRequest) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorGetRequest(response, &metadata)
+	}
+	output := &GetRequestOutput{}
+	out.Result = output
+
+	var buff [1024]byte
+	ringBuffer := smithyio.NewRingBuffer(buff[:])
+
+	body := io.TeeReader(response.Body, ringBuffer)
+
+	decoder := json.NewDecoder(body)
+	decoder.UseNumber()
+	var shape interface{}
+	if err := decoder.Decode(&shape); err != nil && err != io.EOF {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		err = &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+		return out, metadata, err
+	}
+
+	err = cybrRestjson_deserializeOpDocumentGetRequestOutput(&output, shape)
+	if err != nil {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		return out, metadata, &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorGetRequest(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 404:
+		return cybrRestjson_deserializeErrorResourceNotFoundException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+func cybrRestjson_deserializeOpDocumentGetRequestOutput(v **GetRequestOutput, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *GetRequestOutput
+	if *v == nil {
+		sv = &GetRequestOutput{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "AccessFrom":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected EpochTime to be json.Number, got %T instead", value)
+				}
+				f64, err := jtv.Float64()
+				if err != nil {
+					return err
+				}
+				sv.AccessFrom = ptr.Time(smithytime.ParseEpochSeconds(f64))
+			}
+
+		case "AccessTo":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected EpochTime to be json.Number, got %T instead", value)
+				}
+				f64, err := jtv.Float64()
+				if err != nil {
+					return err
+				}
+				sv.AccessTo = ptr.Time(smithytime.ParseEpochSeconds(f64))
+			}
+
+		case "AccessType":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected AccessType to be of type string, got %T instead", value)
+				}
+				sv.AccessType = types.AccessType(jtv)
+			}
+
+		case "AccountId":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.AccountId = ptr.String(jtv)
+			}
+
+		case "ConfirmationsLeft":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected Integer to be json.Number, got %T instead", value)
+				}
+				i64, err := jtv.Int64()
+				if err != nil {
+					return err
+				}
+				sv.ConfirmationsLeft = ptr.Int32(int32(i64))
+			}
+
+		case "CreationDate":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected EpochTime to be json.Number, got %T instead", value)
+				}
+				f64, err := jtv.Float64()
+				if err != nil {
+					return err
+				}
+				sv.CreationDate = ptr.Time(smithytime.ParseEpochSeconds(f64))
+			}
+
+		case "ExpirationDate":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected EpochTime to be json.Number, got %T instead", value)
+				}
+				f64, err := jtv.Float64()
+				if err != nil {
+					return err
+				}
+				sv.ExpirationDate = ptr.Time(smithytime.ParseEpochSeconds(f64))
+			}
+
+		case "MultipleAccessRequired":
+			if value != nil {
+				jtv, ok := value.(bool)
+				if !ok {
+					return fmt.Errorf("expected Boolean to be of type *bool, got %T instead", value)
+				}
+				sv.MultipleAccessRequired = ptr.Bool(jtv)
+			}
+
+		case "Operation":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.Operation = ptr.String(jtv)
+			}
+
+		case "RequestID":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.RequestId = ptr.String(jtv)
+			}
+
+		case "RequestorReason":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.RequestorReason = ptr.String(jtv)
+			}
+
+		case "RequestorUserName":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.RequestorUserName = ptr.String(jtv)
+			}
+
+		case "SafeName":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.SafeName = ptr.String(jtv)
+			}
+
+		case "Status":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected Integer to be json.Number, got %T instead", value)
+				}
+				i64, err := jtv.Int64()
+				if err != nil {
+					return err
+				}
+				sv.Status = ptr.Int32(int32(i64))
+			}
+
+		case "StatusTitle":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected AccessRequestStatus to be of type string, got %T instead", value)
+				}
+				sv.StatusTitle = types.AccessRequestStatus(jtv)
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+type cybrRestjson_deserializeOpImportPlatform struct {
+}
+
+func (*cybrRestjson_deserializeOpImportPlatform) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpImportPlatform) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorImportPlatform(response, &metadata)
+	}
+	output := &ImportPlatformOutput{}
+	out.Result = output
+
+	var buff [1024]byte
+	ringBuffer := smithyio.NewRingBuffer(buff[:])
+
+	body := io.TeeReader(response.Body, ringBuffer)
+
+	decoder := json.NewDecoder(body)
+	decoder.UseNumber()
+	var shape interface{}
+	if err := decoder.Decode(&shape); err != nil && err != io.EOF {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		err = &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+		return out, metadata, err
+	}
+
+	err = cybrRestjson_deserializeOpDocumentImportPlatformOutput(&output, shape)
+	if err != nil {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		return out, metadata, &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorImportPlatform(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 409:
+		return cybrRestjson_deserializeErrorConflictException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+func cybrRestjson_deserializeOpDocumentImportPlatformOutput(v **ImportPlatformOutput, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *ImportPlatformOutput
+	if *v == nil {
+		sv = &ImportPlatformOutput{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "PlatformID":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.PlatformId = ptr.String(jtv)
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+type cybrRestjson_deserializeOpListAccounts struct {
+}
+
+func (*cybrRestjson_deserializeOpListAccounts) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpListAccounts) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorListAccounts(response, &metadata)
+	}
+	output := &ListAccountsOutput{}
+	out.Result = output
+
+	var buff [1024]byte
+	ringBuffer := smithyio.NewRingBuffer(buff[:])
+
+	body := io.TeeReader(response.Body, ringBuffer)
+
+	decoder := json.NewDecoder(body)
+	decoder.UseNumber()
+	var shape interface{}
+	if err := decoder.Decode(&shape); err != nil && err != io.EOF {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		err = &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+		return out, metadata, err
+	}
+
+	err = cybrRestjson_deserializeOpDocumentListAccountsOutput(&output, shape)
+	if err != nil {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		return out, metadata, &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorListAccounts(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+func cybrRestjson_deserializeOpDocumentListAccountsOutput(v **ListAccountsOutput, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *ListAccountsOutput
+	if *v == nil {
+		sv = &ListAccountsOutput{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "count":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected Integer to be json.Number, got %T instead", value)
+				}
+				i64, err := jtv.Int64()
+				if err != nil {
+					return err
+				}
+				sv.Count = ptr.Int32(int32(i64))
+			}
+
+		case "nextLink":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.NextLink = ptr.String(jtv)
+			}
+
+		case "value":
+			if err := cybrRestjson_deserializeDocumentAccountList(&sv.Value, value); err != nil {
+				return err
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+type cybrRestjson_deserializeOpListDependentPlatforms struct {
+}
+
+func (*cybrRestjson_deserializeOpListDependentPlatforms) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpListDependentPlatforms) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorListDependentPlatforms(response, &metadata)
+	}
+	output := &ListDependentPlatformsOutput{}
+	out.Result = output
+
+	var buff [1024]byte
+	ringBuffer := smithyio.NewRingBuffer(buff[:])
+
+	body := io.TeeReader(response.Body, ringBuffer)
+
+	decoder := json.NewDecoder(body)
+	decoder.UseNumber()
+	var shape interface{}
+	if err := decoder.Decode(&shape); err != nil && err != io.EOF {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		err = &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+		return out, metadata, err
+	}
+
+	err = cybrRestjson_deserializeOpDocumentListDependentPlatformsOutput(&output, shape)
+	if err != nil {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		return out, metadata, &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorListDependentPlatforms(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+func cybrRestjson_deserializeOpDocumentListDependentPlatformsOutput(v **ListDependentPlatformsOutput, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *ListDependentPlatformsOutput
+	if *v == nil {
+		sv = &ListDependentPlatformsOutput{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "Platforms":
+			if err := cybrRestjson_deserializeDocumentDependentPlatformList(&sv.Platforms, value); err != nil {
+				return err
+			}
+
+		case "Total":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected Integer to be json.Number, got %T instead", value)
+				}
+				i64, err := jtv.Int64()
+				if err != nil {
+					return err
+				}
+				sv.Total = ptr.Int32(int32(i64))
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+type cybrRestjson_deserializeOpListGroupPlatforms struct {
+}
+
+func (*cybrRestjson_deserializeOpListGroupPlatforms) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpListGroupPlatforms) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorListGroupPlatforms(response, &metadata)
+	}
+	output := &ListGroupPlatformsOutput{}
+	out.Result = output
+
+	var buff [1024]byte
+	ringBuffer := smithyio.NewRingBuffer(buff[:])
+
+	body := io.TeeReader(response.Body, ringBuffer)
+
+	decoder := json.NewDecoder(body)
+	decoder.UseNumber()
+	var shape interface{}
+	if err := decoder.Decode(&shape); err != nil && err != io.EOF {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		err = &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+		return out, metadata, err
+	}
+
+	err = cybrRestjson_deserializeOpDocumentListGroupPlatformsOutput(&output, shape)
+	if err != nil {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		return out, metadata, &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorListGroupPlatforms(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+func cybrRestjson_deserializeOpDocumentListGroupPlatformsOutput(v **ListGroupPlatformsOutput, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *ListGroupPlatformsOutput
+	if *v == nil {
+		sv = &ListGroupPlatformsOutput{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "Platforms":
+			if err := cybrRestjson_deserializeDocumentGroupPlatformList(&sv.Platforms, value); err != nil {
+				return err
+			}
+
+		case "Total":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected Integer to be json.Number, got %T instead", value)
+				}
+				i64, err := jtv.Int64()
+				if err != nil {
+					return err
+				}
+				sv.Total = ptr.Int32(int32(i64))
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+type cybrRestjson_deserializeOpListIncomingRequests struct {
+}
+
+func (*cybrRestjson_deserializeOpListIncomingRequests) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpListIncomingRequests) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorListIncomingRequests(response, &metadata)
+	}
+	output := &ListIncomingRequestsOutput{}
+	out.Result = output
+
+	var buff [1024]byte
+	ringBuffer := smithyio.NewRingBuffer(buff[:])
+
+	body := io.TeeReader(response.Body, ringBuffer)
+
+	decoder := json.NewDecoder(body)
+	decoder.UseNumber()
+	var shape interface{}
+	if err := decoder.Decode(&shape); err != nil && err != io.EOF {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		err = &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+		return out, metadata, err
+	}
+
+	err = cybrRestjson_deserializeOpDocumentListIncomingRequestsOutput(&output, shape)
+	if err != nil {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		return out, metadata, &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorListIncomingRequests(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+func cybrRestjson_deserializeOpDocumentListIncomingRequestsOutput(v **ListIncomingRequestsOutput, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *ListIncomingRequestsOutput
+	if *v == nil {
+		sv = &ListIncomingRequestsOutput{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "IncomingRequests":
+			if err := cybrRestjson_deserializeDocumentAccessRequestList(&sv.IncomingRequests, value); err != nil {
+				return err
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+type cybrRestjson_deserializeOpListLiveSessions struct {
+}
+
+func (*cybrRestjson_deserializeOpListLiveSessions) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpListLiveSessions) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorListLiveSessions(response, &metadata)
+	}
+	output := &ListLiveSessionsOutput{}
+	out.Result = output
+
+	var buff [1024]byte
+	ringBuffer := smithyio.NewRingBuffer(buff[:])
+
+	body := io.TeeReader(response.Body, ringBuffer)
+
+	decoder := json.NewDecoder(body)
+	decoder.UseNumber()
+	var shape interface{}
+	if err := decoder.Decode(&shape); err != nil && err != io.EOF {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		err = &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+		return out, metadata, err
+	}
+
+	err = cybrRestjson_deserializeOpDocumentListLiveSessionsOutput(&output, shape)
+	if err != nil {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		return out, metadata, &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorListLiveSessions(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+func cybrRestjson_deserializeOpDocumentListLiveSessionsOutput(v **ListLiveSessionsOutput, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *ListLiveSessionsOutput
+	if *v == nil {
+		sv = &ListLiveSessionsOutput{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "LiveSessions":
+			if err := cybrRestjson_deserializeDocumentSessionList(&sv.LiveSessions, value); err != nil {
+				return err
+			}
+
+		case "Total":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected Integer to be json.Number, got %T instead", value)
+				}
+				i64, err := jtv.Int64()
+				if err != nil {
+					return err
+				}
+				sv.Total = ptr.Int32(int32(i64))
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+type cybrRestjson_deserializeOpListMyRequests struct {
+}
+
+func (*cybrRestjson_deserializeOpListMyRequests) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpListMyRequests) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorListMyRequests(response, &metadata)
+	}
+	output := &ListMyRequestsOutput{}
+	out.Result = output
+
+	var buff [1024]byte
+	ringBuffer := smithyio.NewRingBuffer(buff[:])
+
+	body := io.TeeReader(response.Body, ringBuffer)
+
+	decoder := json.NewDecoder(body)
+	decoder.UseNumber()
+	var shape interface{}
+	if err := decoder.Decode(&shape); err != nil && err != io.EOF {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		err = &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+		return out, metadata, err
+	}
+
+	err = cybrRestjson_deserializeOpDocumentListMyRequestsOutput(&output, shape)
+	if err != nil {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		return out, metadata, &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorListMyRequests(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+func cybrRestjson_deserializeOpDocumentListMyRequestsOutput(v **ListMyRequestsOutput, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *ListMyRequestsOutput
+	if *v == nil {
+		sv = &ListMyRequestsOutput{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "MyRequests":
+			if err := cybrRestjson_deserializeDocumentAccessRequestList(&sv.MyRequests, value); err != nil {
+				return err
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+type cybrRestjson_deserializeOpListRecordings struct {
+}
+
+func (*cybrRestjson_deserializeOpListRecordings) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpListRecordings) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorListRecordings(response, &metadata)
+	}
+	output := &ListRecordingsOutput{}
+	out.Result = output
+
+	var buff [1024]byte
+	ringBuffer := smithyio.NewRingBuffer(buff[:])
+
+	body := io.TeeReader(response.Body, ringBuffer)
+
+	decoder := json.NewDecoder(body)
+	decoder.UseNumber()
+	var shape interface{}
+	if err := decoder.Decode(&shape); err != nil && err != io.EOF {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		err = &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+		return out, metadata, err
+	}
+
+	err = cybrRestjson_deserializeOpDocumentListRecordingsOutput(&output, shape)
+	if err != nil {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		return out, metadata, &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorListRecordings(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+func cybrRestjson_deserializeOpDocumentListRecordingsOutput(v **ListRecordingsOutput, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *ListRecordingsOutput
+	if *v == nil {
+		sv = &ListRecordingsOutput{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "Recordings":
+			if err := cybrRestjson_deserializeDocumentSessionList(&sv.Recordings, value); err != nil {
+				return err
+			}
+
+		case "Total":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected Integer to be json.Number, got %T instead", value)
+				}
+				i64, err := jtv.Int64()
+				if err != nil {
+					return err
+				}
+				sv.Total = ptr.Int32(int32(i64))
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+type cybrRestjson_deserializeOpListRotationalGroupPlatforms struct {
+}
+
+func (*cybrRestjson_deserializeOpListRotationalGroupPlatforms) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpListRotationalGroupPlatforms) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorListRotationalGroupPlatforms(response, &metadata)
+	}
+	output := &ListRotationalGroupPlatformsOutput{}
+	out.Result = output
+
+	var buff [1024]byte
+	ringBuffer := smithyio.NewRingBuffer(buff[:])
+
+	body := io.TeeReader(response.Body, ringBuffer)
+
+	decoder := json.NewDecoder(body)
+	decoder.UseNumber()
+	var shape interface{}
+	if err := decoder.Decode(&shape); err != nil && err != io.EOF {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		err = &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+		return out, metadata, err
+	}
+
+	err = cybrRestjson_deserializeOpDocumentListRotationalGroupPlatformsOutput(&output, shape)
+	if err != nil {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		return out, metadata, &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorListRotationalGroupPlatforms(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+func cybrRestjson_deserializeOpDocumentListRotationalGroupPlatformsOutput(v **ListRotationalGroupPlatformsOutput, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *ListRotationalGroupPlatformsOutput
+	if *v == nil {
+		sv = &ListRotationalGroupPlatformsOutput{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "Platforms":
+			if err := cybrRestjson_deserializeDocumentRotationalGroupPlatformList(&sv.Platforms, value); err != nil {
+				return err
+			}
+
+		case "Total":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected Integer to be json.Number, got %T instead", value)
+				}
+				i64, err := jtv.Int64()
+				if err != nil {
+					return err
+				}
+				sv.Total = ptr.Int32(int32(i64))
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+type cybrRestjson_deserializeOpListSafes struct {
+}
+
+func (*cybrRestjson_deserializeOpListSafes) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpListSafes) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorListSafes(response, &metadata)
+	}
+	output := &ListSafesOutput{}
+	out.Result = output
+
+	var buff [1024]byte
+	ringBuffer := smithyio.NewRingBuffer(buff[:])
+
+	body := io.TeeReader(response.Body, ringBuffer)
+
+	decoder := json.NewDecoder(body)
+	decoder.UseNumber()
+	var shape interface{}
+	if err := decoder.Decode(&shape); err != nil && err != io.EOF {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		err = &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+		return out, metadata, err
+	}
+
+	err = cybrRestjson_deserializeOpDocumentListSafesOutput(&output, shape)
+	if err != nil {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		return out, metadata, &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorListSafes(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+func cybrRestjson_deserializeOpDocumentListSafesOutput(v **ListSafesOutput, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *ListSafesOutput
+	if *v == nil {
+		sv = &ListSafesOutput{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "count":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected Integer to be json.Number, got %T instead", value)
+				}
+				i64, err := jtv.Int64()
+				if err != nil {
+					return err
+				}
+				sv.Count = ptr.Int32(int32(i64))
+			}
+
+		case "nextLink":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.NextLink = ptr.String(jtv)
+			}
+
+		case "value":
+			if err := cybrRestjson_deserializeDocumentSafeList(&sv.Value, value); err != nil {
+				return err
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+type cybrRestjson_deserializeOpListTargetPlatforms struct {
+}
+
+func (*cybrRestjson_deserializeOpListTargetPlatforms) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpListTargetPlatforms) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorListTargetPlatforms(response, &metadata)
+	}
+	output := &ListTargetPlatformsOutput{}
+	out.Result = output
+
+	var buff [1024]byte
+	ringBuffer := smithyio.NewRingBuffer(buff[:])
+
+	body := io.TeeReader(response.Body, ringBuffer)
+
+	decoder := json.NewDecoder(body)
+	decoder.UseNumber()
+	var shape interface{}
+	if err := decoder.Decode(&shape); err != nil && err != io.EOF {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		err = &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+		return out, metadata, err
+	}
+
+	err = cybrRestjson_deserializeOpDocumentListTargetPlatformsOutput(&output, shape)
+	if err != nil {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		return out, metadata, &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorListTargetPlatforms(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+func cybrRestjson_deserializeOpDocumentListTargetPlatformsOutput(v **ListTargetPlatformsOutput, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *ListTargetPlatformsOutput
+	if *v == nil {
+		sv = &ListTargetPlatformsOutput{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "Platforms":
+			if err := cybrRestjson_deserializeDocumentTargetPlatformList(&sv.Platforms, value); err != nil {
+				return err
+			}
+
+		case "Total":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected Integer to be json.Number, got %T instead", value)
+				}
+				i64, err := jtv.Int64()
+				if err != nil {
+					return err
+				}
+				sv.Total = ptr.Int32(int32(i64))
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+type cybrRestjson_deserializeOpReconcileCredentials struct {
+}
+
+func (*cybrRestjson_deserializeOpReconcileCredentials) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpReconcileCredentials) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorReconcileCredentials(response, &metadata)
+	}
+	output := &ReconcileCredentialsOutput{}
+	out.Result = output
+
+	if _, err = io.Copy(io.Discard, response.Body); err != nil {
+		return out, metadata, &smithy.DeserializationError{
+			Err: fmt.Errorf("failed to discard response body, %w", err),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorReconcileCredentials(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 404:
+		return cybrRestjson_deserializeErrorResourceNotFoundException(errorCode, errorMessage)
+	case 409:
+		return cybrRestjson_deserializeErrorConflictException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+type cybrRestjson_deserializeOpRejectRequest struct {
+}
+
+func (*cybrRestjson_deserializeOpRejectRequest) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpRejectRequest) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorRejectRequest(response, &metadata)
+	}
+	output := &RejectRequestOutput{}
+	out.Result = output
+
+	if _, err = io.Copy(io.Discard, response.Body); err != nil {
+		return out, metadata, &smithy.DeserializationError{
+			Err: fmt.Errorf("failed to discard response body, %w", err),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorRejectRequest(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 404:
+		return cybrRestjson_deserializeErrorResourceNotFoundException(errorCode, errorMessage)
+	case 409:
+		return cybrRestjson_deserializeErrorConflictException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+type cybrRestjson_deserializeOpResumeLiveSession struct {
+}
+
+func (*cybrRestjson_deserializeOpResumeLiveSession) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpResumeLiveSession) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorResumeLiveSession(response, &metadata)
+	}
+	output := &ResumeLiveSessionOutput{}
+	out.Result = output
+
+	if _, err = io.Copy(io.Discard, response.Body); err != nil {
+		return out, metadata, &smithy.DeserializationError{
+			Err: fmt.Errorf("failed to discard response body, %w", err),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorResumeLiveSession(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 404:
+		return cybrRestjson_deserializeErrorResourceNotFoundException(errorCode, errorMessage)
+	case 409:
+		return cybrRestjson_deserializeErrorConflictException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+type cybrRestjson_deserializeOpRetrievePassword struct {
+}
+
+func (*cybrRestjson_deserializeOpRetrievePassword) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpRetrievePassword) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorRetrievePassword(response, &metadata)
+	}
+	output := &RetrievePasswordOutput{}
+	out.Result = output
+
+	var buff [1024]byte
+	ringBuffer := smithyio.NewRingBuffer(buff[:])
+
+	body := io.TeeReader(response.Body, ringBuffer)
+
+	decoder := json.NewDecoder(body)
+	decoder.UseNumber()
+	var shape interface{}
+	if err := decoder.Decode(&shape); err != nil && err != io.EOF {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		err = &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+		return out, metadata, err
+	}
+
+	err = cybrRestjson_deserializeDocumentString(&output.Password, shape)
+	if err != nil {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		return out, metadata, &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorRetrievePassword(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch errorCode {
+	case "ITATS542I":
+		return cybrRestjson_deserializeErrorRequiresReasonException(errorCode, errorMessage)
+	case "ITATS546E":
+		return cybrRestjson_deserializeErrorRequiresTicketException(errorCode, errorMessage)
+	case "ITATS543I", "ITATS544I":
+		return cybrRestjson_deserializeErrorRequiresConfirmationException(errorCode, errorMessage)
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 404:
+		return cybrRestjson_deserializeErrorResourceNotFoundException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+type cybrRestjson_deserializeOpSetNextPassword struct {
+}
+
+func (*cybrRestjson_deserializeOpSetNextPassword) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpSetNextPassword) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorSetNextPassword(response, &metadata)
+	}
+	output := &SetNextPasswordOutput{}
+	out.Result = output
+
+	if _, err = io.Copy(io.Discard, response.Body); err != nil {
+		return out, metadata, &smithy.DeserializationError{
+			Err: fmt.Errorf("failed to discard response body, %w", err),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorSetNextPassword(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 404:
+		return cybrRestjson_deserializeErrorResourceNotFoundException(errorCode, errorMessage)
+	case 409:
+		return cybrRestjson_deserializeErrorConflictException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+type cybrRestjson_deserializeOpSuspendLiveSession struct {
+}
+
+func (*cybrRestjson_deserializeOpSuspendLiveSession) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpSuspendLiveSession) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorSuspendLiveSession(response, &metadata)
+	}
+	output := &SuspendLiveSessionOutput{}
+	out.Result = output
+
+	if _, err = io.Copy(io.Discard, response.Body); err != nil {
+		return out, metadata, &smithy.DeserializationError{
+			Err: fmt.Errorf("failed to discard response body, %w", err),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorSuspendLiveSession(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 404:
+		return cybrRestjson_deserializeErrorResourceNotFoundException(errorCode, errorMessage)
+	case 409:
+		return cybrRestjson_deserializeErrorConflictException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+type cybrRestjson_deserializeOpTerminateLiveSession struct {
+}
+
+func (*cybrRestjson_deserializeOpTerminateLiveSession) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpTerminateLiveSession) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorTerminateLiveSession(response, &metadata)
+	}
+	output := &TerminateLiveSessionOutput{}
+	out.Result = output
+
+	if _, err = io.Copy(io.Discard, response.Body); err != nil {
+		return out, metadata, &smithy.DeserializationError{
+			Err: fmt.Errorf("failed to discard response body, %w", err),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorTerminateLiveSession(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 404:
+		return cybrRestjson_deserializeErrorResourceNotFoundException(errorCode, errorMessage)
+	case 409:
+		return cybrRestjson_deserializeErrorConflictException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+type cybrRestjson_deserializeOpUnlockAccount struct {
+}
+
+func (*cybrRestjson_deserializeOpUnlockAccount) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpUnlockAccount) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorUnlockAccount(response, &metadata)
+	}
+	output := &UnlockAccountOutput{}
+	out.Result = output
+
+	if _, err = io.Copy(io.Discard, response.Body); err != nil {
+		return out, metadata, &smithy.DeserializationError{
+			Err: fmt.Errorf("failed to discard response body, %w", err),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorUnlockAccount(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 404:
+		return cybrRestjson_deserializeErrorResourceNotFoundException(errorCode, errorMessage)
+	case 409:
+		return cybrRestjson_deserializeErrorConflictException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+type cybrRestjson_deserializeOpUpdateAccount struct {
+}
+
+func (*cybrRestjson_deserializeOpUpdateAccount) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpUpdateAccount) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorUpdateAccount(response, &metadata)
+	}
+	output := &UpdateAccountOutput{}
+	out.Result = output
+
+	var buff [1024]byte
+	ringBuffer := smithyio.NewRingBuffer(buff[:])
+
+	body := io.TeeReader(response.Body, ringBuffer)
+
+	decoder := json.NewDecoder(body)
+	decoder.UseNumber()
+	var shape interface{}
+	if err := decoder.Decode(&shape); err != nil && err != io.EOF {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		err = &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+		return out, metadata, err
+	}
+
+	err = cybrRestjson_deserializeOpDocumentUpdateAccountOutput(&output, shape)
+	if err != nil {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		return out, metadata, &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorUpdateAccount(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 404:
+		return cybrRestjson_deserializeErrorResourceNotFoundException(errorCode, errorMessage)
+	case 409:
+		return cybrRestjson_deserializeErrorConflictException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+func cybrRestjson_deserializeOpDocumentUpdateAccountOutput(v **UpdateAccountOutput, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *UpdateAccountOutput
+	if *v == nil {
+		sv = &UpdateAccountOutput{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "address":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.Address = ptr.String(jtv)
+			}
+
+		case "categoryModificationTime":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected EpochTime to be json.Number, got %T instead", value)
+				}
+				f64, err := jtv.Float64()
+				if err != nil {
+					return err
+				}
+				sv.CategoryModificationTime = ptr.Time(smithytime.ParseEpochSeconds(f64))
+			}
+
+		case "createdTime":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected EpochTime to be json.Number, got %T instead", value)
+				}
+				f64, err := jtv.Float64()
+				if err != nil {
+					return err
+				}
+				sv.CreatedTime = ptr.Time(smithytime.ParseEpochSeconds(f64))
+			}
+
+		case "id":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.Id = ptr.String(jtv)
+			}
+
+		case "name":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.Name = ptr.String(jtv)
+			}
+
+		case "platformAccountProperties":
+			if err := cybrRestjson_deserializeDocumentStringMap(&sv.PlatformAccountProperties, value); err != nil {
+				return err
+			}
+
+		case "platformId":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.PlatformId = ptr.String(jtv)
+			}
+
+		case "remoteMachinesAccess":
+			if err := cybrRestjson_deserializeDocumentRemoteMachinesAccess(&sv.RemoteMachinesAccess, value); err != nil {
+				return err
+			}
+
+		case "safeName":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.SafeName = ptr.String(jtv)
+			}
+
+		case "secretManagement":
+			if err := cybrRestjson_deserializeDocumentSecretManagement(&sv.SecretManagement, value); err != nil {
+				return err
+			}
+
+		case "secretType":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected SecretType to be of type string, got %T instead", value)
+				}
+				sv.SecretType = types.SecretType(jtv)
+			}
+
+		case "userName":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.UserName = ptr.String(jtv)
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+type cybrRestjson_deserializeOpUpdateSafe struct {
+}
+
+func (*cybrRestjson_deserializeOpUpdateSafe) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpUpdateSafe) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorUpdateSafe(response, &metadata)
+	}
+	output := &UpdateSafeOutput{}
+	out.Result = output
+
+	var buff [1024]byte
+	ringBuffer := smithyio.NewRingBuffer(buff[:])
+
+	body := io.TeeReader(response.Body, ringBuffer)
+
+	decoder := json.NewDecoder(body)
+	decoder.UseNumber()
+	var shape interface{}
+	if err := decoder.Decode(&shape); err != nil && err != io.EOF {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		err = &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+		return out, metadata, err
+	}
+
+	err = cybrRestjson_deserializeOpDocumentUpdateSafeOutput(&output, shape)
+	if err != nil {
+		var snapshot bytes.Buffer
+		io.Copy(&snapshot, ringBuffer)
+		return out, metadata, &smithy.DeserializationError{
+			Err:      fmt.Errorf("failed to decode response body, %w", err),
+			Snapshot: snapshot.Bytes(),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorUpdateSafe(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 404:
+		return cybrRestjson_deserializeErrorSafeNotFoundException(errorCode, errorMessage)
+	case 409:
+		return cybrRestjson_deserializeErrorConflictException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+func cybrRestjson_deserializeOpDocumentUpdateSafeOutput(v **UpdateSafeOutput, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *UpdateSafeOutput
+	if *v == nil {
+		sv = &UpdateSafeOutput{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "autoPurgeEnabled":
+			if value != nil {
+				jtv, ok := value.(bool)
+				if !ok {
+					return fmt.Errorf("expected Boolean to be of type *bool, got %T instead", value)
+				}
+				sv.AutoPurgeEnabled = ptr.Bool(jtv)
+			}
+
+		case "creationTime":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected EpochTime to be json.Number, got %T instead", value)
+				}
+				f64, err := jtv.Float64()
+				if err != nil {
+					return err
+				}
+				sv.CreationTime = ptr.Time(smithytime.ParseEpochSeconds(f64))
+			}
+
+		case "creator":
+			if err := cybrRestjson_deserializeDocumentSafeCreator(&sv.Creator, value); err != nil {
+				return err
+			}
+
+		case "description":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.Description = ptr.String(jtv)
+			}
+
+		case "isExpiredMember":
+			if value != nil {
+				jtv, ok := value.(bool)
+				if !ok {
+					return fmt.Errorf("expected Boolean to be of type *bool, got %T instead", value)
+				}
+				sv.IsExpiredMember = ptr.Bool(jtv)
+			}
+
+		case "lastModificationTime":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected Long to be json.Number, got %T instead", value)
+				}
+				i64, err := jtv.Int64()
+				if err != nil {
+					return err
+				}
+				sv.LastModificationTime = ptr.Int64(i64)
+			}
+
+		case "location":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.Location = ptr.String(jtv)
+			}
+
+		case "managingCPM":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.ManagingCPM = ptr.String(jtv)
+			}
+
+		case "numberOfDaysRetention":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected Integer to be json.Number, got %T instead", value)
+				}
+				i64, err := jtv.Int64()
+				if err != nil {
+					return err
+				}
+				sv.NumberOfDaysRetention = ptr.Int32(int32(i64))
+			}
+
+		case "numberOfVersionsRetention":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected Integer to be json.Number, got %T instead", value)
+				}
+				i64, err := jtv.Int64()
+				if err != nil {
+					return err
+				}
+				sv.NumberOfVersionsRetention = ptr.Int32(int32(i64))
+			}
+
+		case "olacEnabled":
+			if value != nil {
+				jtv, ok := value.(bool)
+				if !ok {
+					return fmt.Errorf("expected Boolean to be of type *bool, got %T instead", value)
+				}
+				sv.OlacEnabled = ptr.Bool(jtv)
+			}
+
+		case "safeName":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.SafeName = ptr.String(jtv)
+			}
+
+		case "safeNumber":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected Integer to be json.Number, got %T instead", value)
+				}
+				i64, err := jtv.Int64()
+				if err != nil {
+					return err
+				}
+				sv.SafeNumber = ptr.Int32(int32(i64))
+			}
+
+		case "safeUrlId":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.SafeUrlId = ptr.String(jtv)
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+type cybrRestjson_deserializeOpVerifyCredentials struct {
+}
+
+func (*cybrRestjson_deserializeOpVerifyCredentials) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *cybrRestjson_deserializeOpVerifyCredentials) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return out, metadata, cybrRestjson_deserializeOpErrorVerifyCredentials(response, &metadata)
+	}
+	output := &VerifyCredentialsOutput{}
+	out.Result = output
+
+	if _, err = io.Copy(io.Discard, response.Body); err != nil {
+		return out, metadata, &smithy.DeserializationError{
+			Err: fmt.Errorf("failed to discard response body, %w", err),
+		}
+	}
+
+	return out, metadata, err
+}
+
+func cybrRestjson_deserializeOpErrorVerifyCredentials(response *smithyhttp.Response, metadata *middleware.Metadata) error {
+	var errorBuffer bytes.Buffer
+	if _, err := io.Copy(&errorBuffer, response.Body); err != nil {
+		return &smithy.DeserializationError{Err: fmt.Errorf("failed to copy error response body, %w", err)}
+	}
+	errorBody := bytes.NewReader(errorBuffer.Bytes())
+
+	var errorCode, errorMessage string
+	if errorComponents, err := cybrjson.GetErrorResponseComponents(errorBody); err == nil {
+		errorCode = errorComponents.Code
+		errorMessage = errorComponents.Message
+	}
+
+	switch response.StatusCode {
+	case 400:
+		return cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage)
+	case 401:
+		return cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage)
+	case 403:
+		return cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage)
+	case 404:
+		return cybrRestjson_deserializeErrorResourceNotFoundException(errorCode, errorMessage)
+	case 409:
+		return cybrRestjson_deserializeErrorConflictException(errorCode, errorMessage)
+	case 500:
+		return cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage)
+	default:
+		if len(errorCode) == 0 {
+			errorCode = "UnknownError"
+		}
+		if len(errorMessage) == 0 {
+			errorMessage = errorCode
+		}
+		genericError := &smithy.GenericAPIError{
+			Code:    errorCode,
+			Message: errorMessage,
+		}
+		return genericError
+
+	}
+}
+
+func cybrRestjson_deserializeErrorConflictException(errorCode, errorMessage string) error {
+	output := &types.ConflictException{}
+	if len(errorCode) != 0 {
+		output.ErrorCodeOverride = ptr.String(errorCode)
+	}
+	if len(errorMessage) != 0 {
+		output.Message = ptr.String(errorMessage)
+	}
+	return output
+}
+
+func cybrRestjson_deserializeErrorForbiddenException(errorCode, errorMessage string) error {
+	output := &types.ForbiddenException{}
+	if len(errorCode) != 0 {
+		output.ErrorCodeOverride = ptr.String(errorCode)
+	}
+	if len(errorMessage) != 0 {
+		output.Message = ptr.String(errorMessage)
+	}
+	return output
+}
+
+func cybrRestjson_deserializeErrorInternalServerException(errorCode, errorMessage string) error {
+	output := &types.InternalServerException{}
+	if len(errorCode) != 0 {
+		output.ErrorCodeOverride = ptr.String(errorCode)
+	}
+	if len(errorMessage) != 0 {
+		output.Message = ptr.String(errorMessage)
+	}
+	return output
+}
+
+func cybrRestjson_deserializeErrorInvalidRequestException(errorCode, errorMessage string) error {
+	output := &types.InvalidRequestException{}
+	if len(errorCode) != 0 {
+		output.ErrorCodeOverride = ptr.String(errorCode)
+	}
+	if len(errorMessage) != 0 {
+		output.Message = ptr.String(errorMessage)
+	}
+	return output
+}
+
+func cybrRestjson_deserializeErrorRequiresConfirmationException(errorCode, errorMessage string) error {
+	output := &types.RequiresConfirmationException{}
+	if len(errorCode) != 0 {
+		output.ErrorCodeOverride = ptr.String(errorCode)
+	}
+	if len(errorMessage) != 0 {
+		output.Message = ptr.String(errorMessage)
+	}
+	return output
+}
+
+func cybrRestjson_deserializeErrorRequiresReasonException(errorCode, errorMessage string) error {
+	output := &types.RequiresReasonException{}
+	if len(errorCode) != 0 {
+		output.ErrorCodeOverride = ptr.String(errorCode)
+	}
+	if len(errorMessage) != 0 {
+		output.Message = ptr.String(errorMessage)
+	}
+	return output
+}
+
+func cybrRestjson_deserializeErrorRequiresTicketException(errorCode, errorMessage string) error {
+	output := &types.RequiresTicketException{}
+	if len(errorCode) != 0 {
+		output.ErrorCodeOverride = ptr.String(errorCode)
+	}
+	if len(errorMessage) != 0 {
+		output.Message = ptr.String(errorMessage)
+	}
+	return output
+}
+
+func cybrRestjson_deserializeErrorResourceNotFoundException(errorCode, errorMessage string) error {
+	output := &types.ResourceNotFoundException{}
+	if len(errorCode) != 0 {
+		output.ErrorCodeOverride = ptr.String(errorCode)
+	}
+	if len(errorMessage) != 0 {
+		output.Message = ptr.String(errorMessage)
+	}
+	return output
+}
+
+func cybrRestjson_deserializeErrorSafeAlreadyExistsException(errorCode, errorMessage string) error {
+	output := &types.SafeAlreadyExistsException{}
+	if len(errorCode) != 0 {
+		output.ErrorCodeOverride = ptr.String(errorCode)
+	}
+	if len(errorMessage) != 0 {
+		output.Message = ptr.String(errorMessage)
+	}
+	return output
+}
+
+func cybrRestjson_deserializeErrorSafeNotFoundException(errorCode, errorMessage string) error {
+	output := &types.SafeNotFoundException{}
+	if len(errorCode) != 0 {
+		output.ErrorCodeOverride = ptr.String(errorCode)
+	}
+	if len(errorMessage) != 0 {
+		output.Message = ptr.String(errorMessage)
+	}
+	return output
+}
+
+func cybrRestjson_deserializeErrorSafeRetentionPeriodException(errorCode, errorMessage string) error {
+	output := &types.SafeRetentionPeriodException{}
+	if len(errorCode) != 0 {
+		output.ErrorCodeOverride = ptr.String(errorCode)
+	}
+	if len(errorMessage) != 0 {
+		output.Message = ptr.String(errorMessage)
+	}
+	return output
+}
+
+func cybrRestjson_deserializeErrorUnauthorizedException(errorCode, errorMessage string) error {
+	output := &types.UnauthorizedException{}
+	if len(errorCode) != 0 {
+		output.ErrorCodeOverride = ptr.String(errorCode)
+	}
+	if len(errorMessage) != 0 {
+		output.Message = ptr.String(errorMessage)
+	}
+	return output
+}
+
+func cybrRestjson_deserializeDocumentAccessRequest(v **types.AccessRequest, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *types.AccessRequest
+	if *v == nil {
+		sv = &types.AccessRequest{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "AccessFrom":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected EpochTime to be json.Number, got %T instead", value)
+				}
+				f64, err := jtv.Float64()
+				if err != nil {
+					return err
+				}
+				sv.AccessFrom = ptr.Time(smithytime.ParseEpochSeconds(f64))
+			}
+
+		case "AccessTo":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected EpochTime to be json.Number, got %T instead", value)
+				}
+				f64, err := jtv.Float64()
+				if err != nil {
+					return err
+				}
+				sv.AccessTo = ptr.Time(smithytime.ParseEpochSeconds(f64))
+			}
+
+		case "AccessType":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected AccessType to be of type string, got %T instead", value)
+				}
+				sv.AccessType = types.AccessType(jtv)
+			}
+
+		case "AccountId":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.AccountId = ptr.String(jtv)
+			}
+
+		case "ConfirmationsLeft":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected Integer to be json.Number, got %T instead", value)
+				}
+				i64, err := jtv.Int64()
+				if err != nil {
+					return err
+				}
+				sv.ConfirmationsLeft = ptr.Int32(int32(i64))
+			}
+
+		case "CreationDate":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected EpochTime to be json.Number, got %T instead", value)
+				}
+				f64, err := jtv.Float64()
+				if err != nil {
+					return err
+				}
+				sv.CreationDate = ptr.Time(smithytime.ParseEpochSeconds(f64))
+			}
+
+		case "ExpirationDate":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected EpochTime to be json.Number, got %T instead", value)
+				}
+				f64, err := jtv.Float64()
+				if err != nil {
+					return err
+				}
+				sv.ExpirationDate = ptr.Time(smithytime.ParseEpochSeconds(f64))
+			}
+
+		case "MultipleAccessRequired":
+			if value != nil {
+				jtv, ok := value.(bool)
+				if !ok {
+					return fmt.Errorf("expected Boolean to be of type *bool, got %T instead", value)
+				}
+				sv.MultipleAccessRequired = ptr.Bool(jtv)
+			}
+
+		case "Operation":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.Operation = ptr.String(jtv)
+			}
+
+		case "RequestID":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.RequestId = ptr.String(jtv)
+			}
+
+		case "RequestorReason":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.RequestorReason = ptr.String(jtv)
+			}
+
+		case "RequestorUserName":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.RequestorUserName = ptr.String(jtv)
+			}
+
+		case "SafeName":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.SafeName = ptr.String(jtv)
+			}
+
+		case "Status":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected Integer to be json.Number, got %T instead", value)
+				}
+				i64, err := jtv.Int64()
+				if err != nil {
+					return err
+				}
+				sv.Status = ptr.Int32(int32(i64))
+			}
+
+		case "StatusTitle":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected AccessRequestStatus to be of type string, got %T instead", value)
+				}
+				sv.StatusTitle = types.AccessRequestStatus(jtv)
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+func cybrRestjson_deserializeDocumentAccessRequestList(v *[]types.AccessRequest, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.([]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var cv []types.AccessRequest
+	if *v == nil {
+		cv = []types.AccessRequest{}
+	} else {
+		cv = *v
+	}
+
+	for _, value := range shape {
+		var col types.AccessRequest
+		destAddr := &col
+		if err := cybrRestjson_deserializeDocumentAccessRequest(&destAddr, value); err != nil {
+			return err
+		}
+		col = *destAddr
+		cv = append(cv, col)
+	}
+	*v = cv
+	return nil
+}
+
+func cybrRestjson_deserializeDocumentAccount(v **types.Account, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *types.Account
+	if *v == nil {
+		sv = &types.Account{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "address":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.Address = ptr.String(jtv)
+			}
+
+		case "categoryModificationTime":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected EpochTime to be json.Number, got %T instead", value)
+				}
+				f64, err := jtv.Float64()
+				if err != nil {
+					return err
+				}
+				sv.CategoryModificationTime = ptr.Time(smithytime.ParseEpochSeconds(f64))
+			}
+
+		case "createdTime":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected EpochTime to be json.Number, got %T instead", value)
+				}
+				f64, err := jtv.Float64()
+				if err != nil {
+					return err
+				}
+				sv.CreatedTime = ptr.Time(smithytime.ParseEpochSeconds(f64))
+			}
+
+		case "id":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.Id = ptr.String(jtv)
+			}
+
+		case "name":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.Name = ptr.String(jtv)
+			}
+
+		case "platformAccountProperties":
+			if err := cybrRestjson_deserializeDocumentStringMap(&sv.PlatformAccountProperties, value); err != nil {
+				return err
+			}
+
+		case "platformId":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.PlatformId = ptr.String(jtv)
+			}
+
+		case "remoteMachinesAccess":
+			if err := cybrRestjson_deserializeDocumentRemoteMachinesAccess(&sv.RemoteMachinesAccess, value); err != nil {
+				return err
+			}
+
+		case "safeName":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.SafeName = ptr.String(jtv)
+			}
+
+		case "secretManagement":
+			if err := cybrRestjson_deserializeDocumentSecretManagement(&sv.SecretManagement, value); err != nil {
+				return err
+			}
+
+		case "secretType":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected SecretType to be of type string, got %T instead", value)
+				}
+				sv.SecretType = types.SecretType(jtv)
+			}
+
+		case "userName":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.UserName = ptr.String(jtv)
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+func cybrRestjson_deserializeDocumentAccountList(v *[]types.Account, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.([]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var cv []types.Account
+	if *v == nil {
+		cv = []types.Account{}
+	} else {
+		cv = *v
+	}
+
+	for _, value := range shape {
+		var col types.Account
+		destAddr := &col
+		if err := cybrRestjson_deserializeDocumentAccount(&destAddr, value); err != nil {
+			return err
+		}
+		col = *destAddr
+		cv = append(cv, col)
+	}
+	*v = cv
+	return nil
+}
+
+func cybrRestjson_deserializeDocumentCredentialsChangePolicy(v **types.CredentialsChangePolicy, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *types.CredentialsChangePolicy
+	if *v == nil {
+		sv = &types.CredentialsChangePolicy{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "AllowManual":
+			if value != nil {
+				jtv, ok := value.(bool)
+				if !ok {
+					return fmt.Errorf("expected Boolean to be of type *bool, got %T instead", value)
+				}
+				sv.AllowManual = ptr.Bool(jtv)
+			}
+
+		case "AutoOnAdd":
+			if value != nil {
+				jtv, ok := value.(bool)
+				if !ok {
+					return fmt.Errorf("expected Boolean to be of type *bool, got %T instead", value)
+				}
+				sv.AutoOnAdd = ptr.Bool(jtv)
+			}
+
+		case "PerformAutomatic":
+			if value != nil {
+				jtv, ok := value.(bool)
+				if !ok {
+					return fmt.Errorf("expected Boolean to be of type *bool, got %T instead", value)
+				}
+				sv.PerformAutomatic = ptr.Bool(jtv)
+			}
+
+		case "RequirePasswordEveryXDays":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected Integer to be json.Number, got %T instead", value)
+				}
+				i64, err := jtv.Int64()
+				if err != nil {
+					return err
+				}
+				sv.RequirePasswordEveryXDays = ptr.Int32(int32(i64))
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+func cybrRestjson_deserializeDocumentCredentialsManagementPolicy(v **types.CredentialsManagementPolicy, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *types.CredentialsManagementPolicy
+	if *v == nil {
+		sv = &types.CredentialsManagementPolicy{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "Change":
+			if err := cybrRestjson_deserializeDocumentCredentialsChangePolicy(&sv.Change, value); err != nil {
+				return err
+			}
+
+		case "Reconcile":
+			if err := cybrRestjson_deserializeDocumentCredentialsReconcilePolicy(&sv.Reconcile, value); err != nil {
+				return err
+			}
+
+		case "SecretUpdateConfiguration":
+			if err := cybrRestjson_deserializeDocumentSecretUpdateConfiguration(&sv.SecretUpdateConfiguration, value); err != nil {
+				return err
+			}
+
+		case "Verification":
+			if err := cybrRestjson_deserializeDocumentCredentialsVerificationPolicy(&sv.Verification, value); err != nil {
+				return err
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+func cybrRestjson_deserializeDocumentCredentialsReconcilePolicy(v **types.CredentialsReconcilePolicy, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *types.CredentialsReconcilePolicy
+	if *v == nil {
+		sv = &types.CredentialsReconcilePolicy{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "AllowManual":
+			if value != nil {
+				jtv, ok := value.(bool)
+				if !ok {
+					return fmt.Errorf("expected Boolean to be of type *bool, got %T instead", value)
+				}
+				sv.AllowManual = ptr.Bool(jtv)
+			}
+
+		case "AutomaticReconcileWhenUnsynced":
+			if value != nil {
+				jtv, ok := value.(bool)
+				if !ok {
+					return fmt.Errorf("expected Boolean to be of type *bool, got %T instead", value)
+				}
+				sv.AutomaticReconcileWhenUnsynced = ptr.Bool(jtv)
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+func cybrRestjson_deserializeDocumentCredentialsVerificationPolicy(v **types.CredentialsVerificationPolicy, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *types.CredentialsVerificationPolicy
+	if *v == nil {
+		sv = &types.CredentialsVerificationPolicy{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "AllowManual":
+			if value != nil {
+				jtv, ok := value.(bool)
+				if !ok {
+					return fmt.Errorf("expected Boolean to be of type *bool, got %T instead", value)
+				}
+				sv.AllowManual = ptr.Bool(jtv)
+			}
+
+		case "AutoOnAdd":
+			if value != nil {
+				jtv, ok := value.(bool)
+				if !ok {
+					return fmt.Errorf("expected Boolean to be of type *bool, got %T instead", value)
+				}
+				sv.AutoOnAdd = ptr.Bool(jtv)
+			}
+
+		case "PerformAutomatic":
+			if value != nil {
+				jtv, ok := value.(bool)
+				if !ok {
+					return fmt.Errorf("expected Boolean to be of type *bool, got %T instead", value)
+				}
+				sv.PerformAutomatic = ptr.Bool(jtv)
+			}
+
+		case "RequirePasswordEveryXDays":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected Integer to be json.Number, got %T instead", value)
+				}
+				i64, err := jtv.Int64()
+				if err != nil {
+					return err
+				}
+				sv.RequirePasswordEveryXDays = ptr.Int32(int32(i64))
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+func cybrRestjson_deserializeDocumentDependentPlatform(v **types.DependentPlatform, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *types.DependentPlatform
+	if *v == nil {
+		sv = &types.DependentPlatform{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "ID":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected Integer to be json.Number, got %T instead", value)
+				}
+				i64, err := jtv.Int64()
+				if err != nil {
+					return err
+				}
+				sv.Id = ptr.Int32(int32(i64))
+			}
+
+		case "Name":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.Name = ptr.String(jtv)
+			}
+
+		case "PlatformID":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.PlatformId = ptr.String(jtv)
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+func cybrRestjson_deserializeDocumentDependentPlatformList(v *[]types.DependentPlatform, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.([]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var cv []types.DependentPlatform
+	if *v == nil {
+		cv = []types.DependentPlatform{}
+	} else {
+		cv = *v
+	}
+
+	for _, value := range shape {
+		var col types.DependentPlatform
+		destAddr := &col
+		if err := cybrRestjson_deserializeDocumentDependentPlatform(&destAddr, value); err != nil {
+			return err
+		}
+		col = *destAddr
+		cv = append(cv, col)
+	}
+	*v = cv
+	return nil
+}
+
+func cybrRestjson_deserializeDocumentGroupPlatform(v **types.GroupPlatform, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *types.GroupPlatform
+	if *v == nil {
+		sv = &types.GroupPlatform{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "ID":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected Integer to be json.Number, got %T instead", value)
+				}
+				i64, err := jtv.Int64()
+				if err != nil {
+					return err
+				}
+				sv.Id = ptr.Int32(int32(i64))
+			}
+
+		case "Name":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.Name = ptr.String(jtv)
+			}
+
+		case "PlatformID":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.PlatformId = ptr.String(jtv)
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+func cybrRestjson_deserializeDocumentGroupPlatformList(v *[]types.GroupPlatform, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.([]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var cv []types.GroupPlatform
+	if *v == nil {
+		cv = []types.GroupPlatform{}
+	} else {
+		cv = *v
+	}
+
+	for _, value := range shape {
+		var col types.GroupPlatform
+		destAddr := &col
+		if err := cybrRestjson_deserializeDocumentGroupPlatform(&destAddr, value); err != nil {
+			return err
+		}
+		col = *destAddr
+		cv = append(cv, col)
+	}
+	*v = cv
+	return nil
+}
+
+func cybrRestjson_deserializeDocumentPlatformWorkflowSetting(v **types.PlatformWorkflowSetting, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *types.PlatformWorkflowSetting
+	if *v == nil {
+		sv = &types.PlatformWorkflowSetting{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "IsActive":
+			if value != nil {
+				jtv, ok := value.(bool)
+				if !ok {
+					return fmt.Errorf("expected Boolean to be of type *bool, got %T instead", value)
+				}
+				sv.IsActive = ptr.Bool(jtv)
+			}
+
+		case "IsAnException":
+			if value != nil {
+				jtv, ok := value.(bool)
+				if !ok {
+					return fmt.Errorf("expected Boolean to be of type *bool, got %T instead", value)
+				}
+				sv.IsAnException = ptr.Bool(jtv)
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+func cybrRestjson_deserializeDocumentPrivateSSHKey(v **types.PrivateSSHKey, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *types.PrivateSSHKey
+	if *v == nil {
+		sv = &types.PrivateSSHKey{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "format":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected SSHKeyFormat to be of type string, got %T instead", value)
+				}
+				sv.Format = types.SSHKeyFormat(jtv)
+			}
+
+		case "keyAlg":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.KeyAlg = ptr.String(jtv)
+			}
+
+		case "privateKey":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.PrivateKey = ptr.String(jtv)
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+func cybrRestjson_deserializeDocumentPrivateSSHKeyList(v *[]types.PrivateSSHKey, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.([]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var cv []types.PrivateSSHKey
+	if *v == nil {
+		cv = []types.PrivateSSHKey{}
+	} else {
+		cv = *v
+	}
+
+	for _, value := range shape {
+		var col types.PrivateSSHKey
+		destAddr := &col
+		if err := cybrRestjson_deserializeDocumentPrivateSSHKey(&destAddr, value); err != nil {
+			return err
+		}
+		col = *destAddr
+		cv = append(cv, col)
+	}
+	*v = cv
+	return nil
+}
+
+func cybrRestjson_deserializeDocumentPrivilegedAccessWorkflows(v **types.PrivilegedAccessWorkflows, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *types.PrivilegedAccessWorkflows
+	if *v == nil {
+		sv = &types.PrivilegedAccessWorkflows{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "EnforceCheckinCheckoutExclusiveAccess":
+			if err := cybrRestjson_deserializeDocumentPlatformWorkflowSetting(&sv.EnforceCheckinCheckoutExclusiveAccess, value); err != nil {
+				return err
+			}
+
+		case "EnforceOnetimePasswordAccess":
+			if err := cybrRestjson_deserializeDocumentPlatformWorkflowSetting(&sv.EnforceOnetimePasswordAccess, value); err != nil {
+				return err
+			}
+
+		case "RequireDualControlPasswordAccessApproval":
+			if err := cybrRestjson_deserializeDocumentPlatformWorkflowSetting(&sv.RequireDualControlPasswordAccessApproval, value); err != nil {
+				return err
+			}
+
+		case "RequireUsersToSpecifyReasonForAccess":
+			if err := cybrRestjson_deserializeDocumentPlatformWorkflowSetting(&sv.RequireUsersToSpecifyReasonForAccess, value); err != nil {
+				return err
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+func cybrRestjson_deserializeDocumentPrivilegedSessionManagement(v **types.PrivilegedSessionManagement, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *types.PrivilegedSessionManagement
+	if *v == nil {
+		sv = &types.PrivilegedSessionManagement{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "PSMServerId":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.PSMServerId = ptr.String(jtv)
+			}
+
+		case "PSMServerName":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.PSMServerName = ptr.String(jtv)
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+func cybrRestjson_deserializeDocumentRecordingActivity(v **types.RecordingActivity, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *types.RecordingActivity
+	if *v == nil {
+		sv = &types.RecordingActivity{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "Command":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.Command = ptr.String(jtv)
+			}
+
+		case "RiskScore":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected Double to be json.Number, got %T instead", value)
+				}
+				f64, err := jtv.Float64()
+				if err != nil {
+					return err
+				}
+				sv.RiskScore = ptr.Float64(f64)
+			}
+
+		case "Time":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected EpochTime to be json.Number, got %T instead", value)
+				}
+				f64, err := jtv.Float64()
+				if err != nil {
+					return err
+				}
+				sv.Time = ptr.Time(smithytime.ParseEpochSeconds(f64))
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+func cybrRestjson_deserializeDocumentRecordingActivityList(v *[]types.RecordingActivity, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.([]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var cv []types.RecordingActivity
+	if *v == nil {
+		cv = []types.RecordingActivity{}
+	} else {
+		cv = *v
+	}
+
+	for _, value := range shape {
+		var col types.RecordingActivity
+		destAddr := &col
+		if err := cybrRestjson_deserializeDocumentRecordingActivity(&destAddr, value); err != nil {
+			return err
+		}
+		col = *destAddr
+		cv = append(cv, col)
+	}
+	*v = cv
+	return nil
+}
+
+func cybrRestjson_deserializeDocumentRecordingFile(v **types.RecordingFile, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *types.RecordingFile
+	if *v == nil {
+		sv = &types.RecordingFile{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "FileName":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.FileName = ptr.String(jtv)
+			}
+
+		case "FileSize":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected Long to be json.Number, got %T instead", value)
+				}
+				i64, err := jtv.Int64()
+				if err != nil {
+					return err
+				}
+				sv.FileSize = ptr.Int64(i64)
+			}
+
+		case "RecordingType":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.RecordingType = ptr.String(jtv)
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+func cybrRestjson_deserializeDocumentRecordingFileList(v *[]types.RecordingFile, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.([]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var cv []types.RecordingFile
+	if *v == nil {
+		cv = []types.RecordingFile{}
+	} else {
+		cv = *v
+	}
+
+	for _, value := range shape {
+		var col types.RecordingFile
+		destAddr := &col
+		if err := cybrRestjson_deserializeDocumentRecordingFile(&destAddr, value); err != nil {
+			return err
+		}
+		col = *destAddr
+		cv = append(cv, col)
+	}
+	*v = cv
+	return nil
+}
+
+func cybrRestjson_deserializeDocumentRemoteMachinesAccess(v **types.RemoteMachinesAccess, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *types.RemoteMachinesAccess
+	if *v == nil {
+		sv = &types.RemoteMachinesAccess{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "accessRestrictedToRemoteMachines":
+			if value != nil {
+				jtv, ok := value.(bool)
+				if !ok {
+					return fmt.Errorf("expected Boolean to be of type *bool, got %T instead", value)
+				}
+				sv.AccessRestrictedToRemoteMachines = ptr.Bool(jtv)
+			}
+
+		case "remoteMachines":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.RemoteMachines = ptr.String(jtv)
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+func cybrRestjson_deserializeDocumentRotationalGroupPlatform(v **types.RotationalGroupPlatform, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *types.RotationalGroupPlatform
+	if *v == nil {
+		sv = &types.RotationalGroupPlatform{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "ID":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected Integer to be json.Number, got %T instead", value)
+				}
+				i64, err := jtv.Int64()
+				if err != nil {
+					return err
+				}
+				sv.Id = ptr.Int32(int32(i64))
+			}
+
+		case "Name":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.Name = ptr.String(jtv)
+			}
+
+		case "PlatformID":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.PlatformId = ptr.String(jtv)
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+func cybrRestjson_deserializeDocumentRotationalGroupPlatformList(v *[]types.RotationalGroupPlatform, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.([]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var cv []types.RotationalGroupPlatform
+	if *v == nil {
+		cv = []types.RotationalGroupPlatform{}
+	} else {
+		cv = *v
+	}
+
+	for _, value := range shape {
+		var col types.RotationalGroupPlatform
+		destAddr := &col
+		if err := cybrRestjson_deserializeDocumentRotationalGroupPlatform(&destAddr, value); err != nil {
+			return err
+		}
+		col = *destAddr
+		cv = append(cv, col)
+	}
+	*v = cv
+	return nil
+}
+
+func cybrRestjson_deserializeDocumentSafe(v **types.Safe, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *types.Safe
+	if *v == nil {
+		sv = &types.Safe{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "autoPurgeEnabled":
+			if value != nil {
+				jtv, ok := value.(bool)
+				if !ok {
+					return fmt.Errorf("expected Boolean to be of type *bool, got %T instead", value)
+				}
+				sv.AutoPurgeEnabled = ptr.Bool(jtv)
+			}
+
+		case "creationTime":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected EpochTime to be json.Number, got %T instead", value)
+				}
+				f64, err := jtv.Float64()
+				if err != nil {
+					return err
+				}
+				sv.CreationTime = ptr.Time(smithytime.ParseEpochSeconds(f64))
+			}
+
+		case "creator":
+			if err := cybrRestjson_deserializeDocumentSafeCreator(&sv.Creator, value); err != nil {
+				return err
+			}
+
+		case "description":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.Description = ptr.String(jtv)
+			}
+
+		case "isExpiredMember":
+			if value != nil {
+				jtv, ok := value.(bool)
+				if !ok {
+					return fmt.Errorf("expected Boolean to be of type *bool, got %T instead", value)
+				}
+				sv.IsExpiredMember = ptr.Bool(jtv)
+			}
+
+		case "lastModificationTime":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected Long to be json.Number, got %T instead", value)
+				}
+				i64, err := jtv.Int64()
+				if err != nil {
+					return err
+				}
+				sv.LastModificationTime = ptr.Int64(i64)
+			}
+
+		case "location":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.Location = ptr.String(jtv)
+			}
+
+		case "managingCPM":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.ManagingCPM = ptr.String(jtv)
+			}
+
+		case "numberOfDaysRetention":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected Integer to be json.Number, got %T instead", value)
+				}
+				i64, err := jtv.Int64()
+				if err != nil {
+					return err
+				}
+				sv.NumberOfDaysRetention = ptr.Int32(int32(i64))
+			}
+
+		case "numberOfVersionsRetention":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected Integer to be json.Number, got %T instead", value)
+				}
+				i64, err := jtv.Int64()
+				if err != nil {
+					return err
+				}
+				sv.NumberOfVersionsRetention = ptr.Int32(int32(i64))
+			}
+
+		case "olacEnabled":
+			if value != nil {
+				jtv, ok := value.(bool)
+				if !ok {
+					return fmt.Errorf("expected Boolean to be of type *bool, got %T instead", value)
+				}
+				sv.OlacEnabled = ptr.Bool(jtv)
+			}
+
+		case "safeName":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.SafeName = ptr.String(jtv)
+			}
+
+		case "safeNumber":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected Integer to be json.Number, got %T instead", value)
+				}
+				i64, err := jtv.Int64()
+				if err != nil {
+					return err
+				}
+				sv.SafeNumber = ptr.Int32(int32(i64))
+			}
+
+		case "safeUrlId":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.SafeUrlId = ptr.String(jtv)
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+func cybrRestjson_deserializeDocumentSafeCreator(v **types.SafeCreator, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *types.SafeCreator
+	if *v == nil {
+		sv = &types.SafeCreator{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "id":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.Id = ptr.String(jtv)
+			}
+
+		case "name":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.Name = ptr.String(jtv)
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+func cybrRestjson_deserializeDocumentSafeList(v *[]types.Safe, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.([]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var cv []types.Safe
+	if *v == nil {
+		cv = []types.Safe{}
+	} else {
+		cv = *v
+	}
+
+	for _, value := range shape {
+		var col types.Safe
+		destAddr := &col
+		if err := cybrRestjson_deserializeDocumentSafe(&destAddr, value); err != nil {
+			return err
+		}
+		col = *destAddr
+		cv = append(cv, col)
+	}
+	*v = cv
+	return nil
+}
+
+func cybrRestjson_deserializeDocumentSecretManagement(v **types.SecretManagement, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *types.SecretManagement
+	if *v == nil {
+		sv = &types.SecretManagement{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "automaticManagementEnabled":
+			if value != nil {
+				jtv, ok := value.(bool)
+				if !ok {
+					return fmt.Errorf("expected Boolean to be of type *bool, got %T instead", value)
+				}
+				sv.AutomaticManagementEnabled = ptr.Bool(jtv)
+			}
+
+		case "lastModifiedTime":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected EpochTime to be json.Number, got %T instead", value)
+				}
+				f64, err := jtv.Float64()
+				if err != nil {
+					return err
+				}
+				sv.LastModifiedTime = ptr.Time(smithytime.ParseEpochSeconds(f64))
+			}
+
+		case "lastReconciledTime":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected EpochTime to be json.Number, got %T instead", value)
+				}
+				f64, err := jtv.Float64()
+				if err != nil {
+					return err
+				}
+				sv.LastReconciledTime = ptr.Time(smithytime.ParseEpochSeconds(f64))
+			}
+
+		case "lastVerifiedTime":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected EpochTime to be json.Number, got %T instead", value)
+				}
+				f64, err := jtv.Float64()
+				if err != nil {
+					return err
+				}
+				sv.LastVerifiedTime = ptr.Time(smithytime.ParseEpochSeconds(f64))
+			}
+
+		case "manualManagementReason":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.ManualManagementReason = ptr.String(jtv)
+			}
+
+		case "status":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected SecretManagementStatus to be of type string, got %T instead", value)
+				}
+				sv.Status = types.SecretManagementStatus(jtv)
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+func cybrRestjson_deserializeDocumentSecretUpdateConfiguration(v **types.SecretUpdateConfiguration, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *types.SecretUpdateConfiguration
+	if *v == nil {
+		sv = &types.SecretUpdateConfiguration{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "ChangePasswordInResetMode":
+			if value != nil {
+				jtv, ok := value.(bool)
+				if !ok {
+					return fmt.Errorf("expected Boolean to be of type *bool, got %T instead", value)
+				}
+				sv.ChangePasswordInResetMode = ptr.Bool(jtv)
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+func cybrRestjson_deserializeDocumentSession(v **types.Session, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *types.Session
+	if *v == nil {
+		sv = &types.Session{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "AccountAddress":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.AccountAddress = ptr.String(jtv)
+			}
+
+		case "AccountPlatformID":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.AccountPlatformId = ptr.String(jtv)
+			}
+
+		case "AccountUsername":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.AccountUsername = ptr.String(jtv)
+			}
+
+		case "CanMonitor":
+			if value != nil {
+				jtv, ok := value.(bool)
+				if !ok {
+					return fmt.Errorf("expected Boolean to be of type *bool, got %T instead", value)
+				}
+				sv.CanMonitor = ptr.Bool(jtv)
+			}
+
+		case "CanSuspend":
+			if value != nil {
+				jtv, ok := value.(bool)
+				if !ok {
+					return fmt.Errorf("expected Boolean to be of type *bool, got %T instead", value)
+				}
+				sv.CanSuspend = ptr.Bool(jtv)
+			}
+
+		case "CanTerminate":
+			if value != nil {
+				jtv, ok := value.(bool)
+				if !ok {
+					return fmt.Errorf("expected Boolean to be of type *bool, got %T instead", value)
+				}
+				sv.CanTerminate = ptr.Bool(jtv)
+			}
+
+		case "Client":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.Client = ptr.String(jtv)
+			}
+
+		case "ConnectionComponentID":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.ConnectionComponentId = ptr.String(jtv)
+			}
+
+		case "Duration":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected Long to be json.Number, got %T instead", value)
+				}
+				i64, err := jtv.Int64()
+				if err != nil {
+					return err
+				}
+				sv.Duration = ptr.Int64(i64)
+			}
+
+		case "End":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected EpochTime to be json.Number, got %T instead", value)
+				}
+				f64, err := jtv.Float64()
+				if err != nil {
+					return err
+				}
+				sv.End = ptr.Time(smithytime.ParseEpochSeconds(f64))
+			}
+
+		case "FileName":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.FileName = ptr.String(jtv)
+			}
+
+		case "FromIP":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.FromIP = ptr.String(jtv)
+			}
+
+		case "IsLive":
+			if value != nil {
+				jtv, ok := value.(bool)
+				if !ok {
+					return fmt.Errorf("expected Boolean to be of type *bool, got %T instead", value)
+				}
+				sv.IsLive = ptr.Bool(jtv)
+			}
+
+		case "Protocol":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.Protocol = ptr.String(jtv)
+			}
+
+		case "RecordingFiles":
+			if err := cybrRestjson_deserializeDocumentRecordingFileList(&sv.RecordingFiles, value); err != nil {
+				return err
+			}
+
+		case "RemoteMachine":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.RemoteMachine = ptr.String(jtv)
+			}
+
+		case "RiskScore":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected Double to be json.Number, got %T instead", value)
+				}
+				f64, err := jtv.Float64()
+				if err != nil {
+					return err
+				}
+				sv.RiskScore = ptr.Float64(f64)
+			}
+
+		case "SafeName":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.SafeName = ptr.String(jtv)
+			}
+
+		case "SessionGuid":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.SessionGuid = ptr.String(jtv)
+			}
+
+		case "SessionID":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.SessionId = ptr.String(jtv)
+			}
+
+		case "Start":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected EpochTime to be json.Number, got %T instead", value)
+				}
+				f64, err := jtv.Float64()
+				if err != nil {
+					return err
+				}
+				sv.Start = ptr.Time(smithytime.ParseEpochSeconds(f64))
+			}
+
+		case "User":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.User = ptr.String(jtv)
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+func cybrRestjson_deserializeDocumentSessionList(v *[]types.Session, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.([]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var cv []types.Session
+	if *v == nil {
+		cv = []types.Session{}
+	} else {
+		cv = *v
+	}
+
+	for _, value := range shape {
+		var col types.Session
+		destAddr := &col
+		if err := cybrRestjson_deserializeDocumentSession(&destAddr, value); err != nil {
+			return err
+		}
+		col = *destAddr
+		cv = append(cv, col)
+	}
+	*v = cv
+	return nil
+}
+
+func cybrRestjson_deserializeDocumentString(v **string, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	jtv, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("expected String to be of type string, got %T instead", value)
+	}
+	*v = ptr.String(jtv)
+	return nil
+}
+
+func cybrRestjson_deserializeDocumentStringMap(v *map[string]string, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var mv map[string]string
+	if *v == nil {
+		mv = map[string]string{}
+	} else {
+		mv = *v
+	}
+
+	for key, value := range shape {
+		var parsedVal string
+		if value != nil {
+			jtv, ok := value.(string)
+			if !ok {
+				return fmt.Errorf("expected String to be of type string, got %T instead", value)
+			}
+			parsedVal = jtv
+		}
+		mv[key] = parsedVal
+	}
+	*v = mv
+	return nil
+}
+
+func cybrRestjson_deserializeDocumentTargetPlatform(v **types.TargetPlatform, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var sv *types.TargetPlatform
+	if *v == nil {
+		sv = &types.TargetPlatform{}
+	} else {
+		sv = *v
+	}
+
+	for key, value := range shape {
+		switch key {
+		case "Active":
+			if value != nil {
+				jtv, ok := value.(bool)
+				if !ok {
+					return fmt.Errorf("expected Boolean to be of type *bool, got %T instead", value)
+				}
+				sv.Active = ptr.Bool(jtv)
+			}
+
+		case "AllowedSafes":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.AllowedSafes = ptr.String(jtv)
+			}
+
+		case "CredentialsManagementPolicy":
+			if err := cybrRestjson_deserializeDocumentCredentialsManagementPolicy(&sv.CredentialsManagementPolicy, value); err != nil {
+				return err
+			}
+
+		case "ID":
+			if value != nil {
+				jtv, ok := value.(json.Number)
+				if !ok {
+					return fmt.Errorf("expected Integer to be json.Number, got %T instead", value)
+				}
+				i64, err := jtv.Int64()
+				if err != nil {
+					return err
+				}
+				sv.Id = ptr.Int32(int32(i64))
+			}
+
+		case "Name":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.Name = ptr.String(jtv)
+			}
+
+		case "PlatformID":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.PlatformId = ptr.String(jtv)
+			}
+
+		case "PrivilegedAccessWorkflows":
+			if err := cybrRestjson_deserializeDocumentPrivilegedAccessWorkflows(&sv.PrivilegedAccessWorkflows, value); err != nil {
+				return err
+			}
+
+		case "PrivilegedSessionManagement":
+			if err := cybrRestjson_deserializeDocumentPrivilegedSessionManagement(&sv.PrivilegedSessionManagement, value); err != nil {
+				return err
+			}
+
+		case "SystemType":
+			if value != nil {
+				jtv, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("expected String to be of type string, got %T instead", value)
+				}
+				sv.SystemType = ptr.String(jtv)
+			}
+
+		default:
+			_, _ = key, value
+
+		}
+	}
+	*v = sv
+	return nil
+}
+
+func cybrRestjson_deserializeDocumentTargetPlatformList(v *[]types.TargetPlatform, value interface{}) error {
+	if v == nil {
+		return fmt.Errorf("unexpected nil of type %T", v)
+	}
+	if value == nil {
+		return nil
+	}
+
+	shape, ok := value.([]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected JSON type %v", value)
+	}
+
+	var cv []types.TargetPlatform
+	if *v == nil {
+		cv = []types.TargetPlatform{}
+	} else {
+		cv = *v
+	}
+
+	for _, value := range shape {
+		var col types.TargetPlatform
+		destAddr := &col
+		if err := cybrRestjson_deserializeDocumentTargetPlatform(&destAddr, value); err != nil {
+			return err
+		}
+		col = *destAddr
+		cv = append(cv, col)
+	}
+	*v = cv
+	return nil
+}
@@ -0,0 +1,8 @@
+// Package privilegecloud provides the API client, operations, and parameter
+// types for the CyberArk Privilege Cloud REST API.
+//
+// The operations, their inputs and outputs, and the types package are
+// generated from the OpenAPI document of the API, see internal/codegen.
+package privilegecloud
+
+//go:generate go run ../../internal/codegen -spec ../../internal/codegen/specs/privilegecloud.json -out .
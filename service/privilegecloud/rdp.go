@@ -0,0 +1,31 @@
+package privilegecloud
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/strick-j/cybr-sdk-alpha/internal/atomicfile"
+)
+
+// rdpFileMode is the permissions of the RDP files written by WriteRDPFile,
+// readable by the owner only as the file holds the connection's token.
+const rdpFileMode os.FileMode = 0600
+
+// WriteRDPFile writes the RDPFile of a ConnectAccount or AdHocConnect output
+// to the file at path, to be opened with a Remote Desktop client.
+//
+// The contents are written as returned by PSM, without converting their
+// encoding or line endings, as Remote Desktop clients detect the encoding of
+// an RDP file, e.g. UTF-16 with a byte order mark, from its contents. The file
+// is written atomically, and is readable by its owner only. An error is
+// returned if rdpFile is empty, e.g. the connection is an HTML5 gateway
+// connection rather than an RDP file.
+func WriteRDPFile(path string, rdpFile []byte) error {
+	if len(rdpFile) == 0 {
+		return fmt.Errorf("no RDP file to write to %s, the connection may be a PSM HTML5 gateway connection", path)
+	}
+	if err := atomicfile.WriteFile(path, rdpFile, rdpFileMode); err != nil {
+		return fmt.Errorf("failed to write RDP file, %w", err)
+	}
+	return nil
+}
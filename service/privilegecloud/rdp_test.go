@@ -0,0 +1,146 @@
+package privilegecloud
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/strick-j/cybr-sdk-alpha/cybr"
+	"github.com/strick-j/cybr-sdk-alpha/service/privilegecloud/types"
+	smithyhttp "github.com/strick-j/smithy-go/transport/http"
+)
+
+func TestClient_ConnectAccount(t *testing.T) {
+	// RDP files are commonly UTF-16LE encoded with a byte order mark.
+	rdpFile := []byte("\xff\xfef\x00u\x00l\x00l\x00 \x00a\x00d\x00d\x00r\x00e\x00s\x00s\x00\r\x00\n\x00")
+
+	cases := map[string]struct {
+		ContentType      string
+		Body             []byte
+		ExpectRDPFile    []byte
+		ExpectGatewayURL string
+		ExpectToken      string
+	}{
+		"rdp file": {
+			ContentType:   "application/octet-stream",
+			Body:          rdpFile,
+			ExpectRDPFile: rdpFile,
+		},
+		"html5 gateway": {
+			ContentType:      "application/json; charset=utf-8",
+			Body:             []byte(`{"PSMGWURL":"https://psmgw.example.com/guac/default.aspx","PSMGWRequest":"token"}`),
+			ExpectGatewayURL: "https://psmgw.example.com/guac/default.aspx",
+			ExpectToken:      "token",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var request map[string]interface{}
+			var accept, path string
+			client := newTestClient(smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+				accept = r.Header.Get("Accept")
+				path = r.URL.Path
+				if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+					t.Errorf("expect JSON request body, got %v", err)
+				}
+				return &http.Response{
+					StatusCode: 200,
+					Header:     http.Header{"Content-Type": []string{c.ContentType}},
+					Body:       io.NopCloser(bytes.NewReader(c.Body)),
+				}, nil
+			}))
+
+			out, err := client.ConnectAccount(context.Background(), &ConnectAccountInput{
+				AccountId:           cybr.String("12_3"),
+				ConnectionComponent: cybr.String("PSM-RDP"),
+				Reason:              cybr.String("maintenance"),
+				ConnectionParams: &types.ConnectionParameters{
+					AllowMappingLocalDrives: &types.FlagConnectionParameter{Value: types.ConnectionFlagNo},
+					LogonDomain:             &types.StringConnectionParameter{Value: cybr.String("example.com")},
+				},
+			})
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			if e, a := "/PasswordVault/API/Accounts/12_3/PSMConnect", path; e != a {
+				t.Errorf("expect path %v, got %v", e, a)
+			}
+			if e, a := "application/json, application/octet-stream", accept; e != a {
+				t.Errorf("expect Accept %v, got %v", e, a)
+			}
+			expectRequest := map[string]interface{}{
+				"ConnectionComponent": "PSM-RDP",
+				"reason":              "maintenance",
+				"ConnectionParams": map[string]interface{}{
+					"AllowMappingLocalDrives": map[string]interface{}{"value": "No"},
+					"LogonDomain":             map[string]interface{}{"value": "example.com"},
+				},
+			}
+			if e, a := mustMarshal(t, expectRequest), mustMarshal(t, request); e != a {
+				t.Errorf("expect request %v, got %v", e, a)
+			}
+
+			if e, a := c.ExpectRDPFile, out.RDPFile; !bytes.Equal(e, a) {
+				t.Errorf("expect RDP file %q, got %q", e, a)
+			}
+			if e, a := c.ExpectGatewayURL, cybr.ToString(out.PSMGWURL); e != a {
+				t.Errorf("expect gateway URL %v, got %v", e, a)
+			}
+			if e, a := c.ExpectToken, cybr.ToString(out.PSMGWRequest); e != a {
+				t.Errorf("expect token %v, got %v", e, a)
+			}
+		})
+	}
+}
+
+func mustMarshal(t *testing.T, v interface{}) string {
+	t.Helper()
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	return string(b)
+}
+
+func TestWriteRDPFile(t *testing.T) {
+	rdpFile := []byte("full address:s:psm.example.com\r\nalternate shell:s:psm /u user /a target\r\n")
+	path := filepath.Join(t.TempDir(), "connections", "target.rdp")
+
+	if err := WriteRDPFile(path, rdpFile); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := rdpFile, b; !bytes.Equal(e, a) {
+		t.Errorf("expect RDP file %q, got %q", e, a)
+	}
+
+	if runtime.GOOS != "windows" {
+		info, err := os.Stat(path)
+		if err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+		if e, a := os.FileMode(0600), info.Mode().Perm(); e != a {
+			t.Errorf("expect file mode %v, got %v", e, a)
+		}
+	}
+
+	err = WriteRDPFile(filepath.Join(t.TempDir(), "gateway.rdp"), nil)
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	if e, a := "HTML5 gateway", err.Error(); !strings.Contains(a, e) {
+		t.Errorf("expect error to contain %q, got %v", e, a)
+	}
+}
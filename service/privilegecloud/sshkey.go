@@ -0,0 +1,127 @@
+package privilegecloud
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/strick-j/cybr-sdk-alpha/internal/atomicfile"
+	"github.com/strick-j/cybr-sdk-alpha/internal/shareddefaults"
+	"github.com/strick-j/cybr-sdk-alpha/service/privilegecloud/types"
+)
+
+// sshKeyFileMode is the permissions of the SSH keys written by WriteSSHKey,
+// as ssh refuses private keys readable by other users.
+const sshKeyFileMode os.FileMode = 0600
+
+// userHomeDir returns the user's home directory, or an empty string if it is
+// unknown.
+var userHomeDir = shareddefaults.UserHomeDir
+
+// MFACachingSSHKey is the MFA caching SSH key returned by
+// GenerateMFACachingSSHKey or GetMFACachingSSHKey, written to a file with
+// WriteSSHKey. It is implemented by the outputs of the operations.
+type MFACachingSSHKey interface {
+	mfaCachingSSHKey() ([]types.PrivateSSHKey, *time.Time)
+}
+
+func (o *GenerateMFACachingSSHKeyOutput) mfaCachingSSHKey() ([]types.PrivateSSHKey, *time.Time) {
+	if o == nil {
+		return nil, nil
+	}
+	return o.PrivateKeys, o.ExpirationTime
+}
+
+func (o *GetMFACachingSSHKeyOutput) mfaCachingSSHKey() ([]types.PrivateSSHKey, *time.Time) {
+	if o == nil {
+		return nil, nil
+	}
+	return o.PrivateKeys, o.ExpirationTime
+}
+
+// WriteSSHKeyOptions are the options of WriteSSHKey.
+type WriteSSHKeyOptions struct {
+	// The path of the file the key is written to. Defaults to the file of the
+	// key's format in the .ssh directory of the user's home directory, see
+	// SSHKeyPath.
+	Path string
+}
+
+// SSHKeyPath returns the path WriteSSHKey writes the MFA caching SSH key in
+// the format to by default, in the .ssh directory of the user's home
+// directory. An error is returned if the user's home directory is unknown.
+//
+//   - OpenSSH: ~/.ssh/cyberark_mfa_caching_key
+//   - PPK: ~/.ssh/cyberark_mfa_caching_key.ppk
+//   - PEM: ~/.ssh/cyberark_mfa_caching_key.pem
+func SSHKeyPath(format types.SSHKeyFormat) (string, error) {
+	home := userHomeDir()
+	if len(home) == 0 {
+		return "", fmt.Errorf("unable to determine the user's home directory for the %s SSH key path", format)
+	}
+
+	name := "cyberark_mfa_caching_key"
+	switch format {
+	case types.SSHKeyFormatPPK:
+		name += ".ppk"
+	case types.SSHKeyFormatPEM:
+		name += ".pem"
+	}
+	return filepath.Join(home, ".ssh", name), nil
+}
+
+// WriteSSHKey writes the private key in the format, of the key returned by a
+// GenerateMFACachingSSHKey or GetMFACachingSSHKey output, to a file readable
+// by its owner only, and returns the time the key expires at from the
+// output's ExpirationTime. The zero time is returned if the output has no
+// ExpirationTime.
+//
+// The file is written atomically, replacing the key written previously. An
+// error is returned if the output has no key in the format, e.g. the key was
+// not generated in the format.
+func WriteSSHKey(key MFACachingSSHKey, format types.SSHKeyFormat, optFns ...func(*WriteSSHKeyOptions)) (time.Time, error) {
+	options := WriteSSHKeyOptions{}
+	for _, fn := range optFns {
+		fn(&options)
+	}
+	if len(options.Path) == 0 {
+		path, err := SSHKeyPath(format)
+		if err != nil {
+			return time.Time{}, err
+		}
+		options.Path = path
+	}
+
+	var keys []types.PrivateSSHKey
+	var expirationTime *time.Time
+	if key != nil {
+		keys, expirationTime = key.mfaCachingSSHKey()
+	}
+
+	var privateKey *string
+	for _, k := range keys {
+		if k.Format == format {
+			privateKey = k.PrivateKey
+			break
+		}
+	}
+	if privateKey == nil || len(*privateKey) == 0 {
+		return time.Time{}, fmt.Errorf("no %s private key to write to %s", format, options.Path)
+	}
+
+	// ssh fails to parse private keys without a trailing newline.
+	content := *privateKey
+	if !strings.HasSuffix(content, "\n") {
+		content += "\n"
+	}
+	if err := atomicfile.WriteFile(options.Path, []byte(content), sshKeyFileMode); err != nil {
+		return time.Time{}, fmt.Errorf("failed to write SSH key, %w", err)
+	}
+
+	if expirationTime == nil {
+		return time.Time{}, nil
+	}
+	return *expirationTime, nil
+}
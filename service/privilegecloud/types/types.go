@@ -0,0 +1,536 @@
+// Code generated by internal/codegen DO NOT EDIT.
+
+package types
+
+import (
+	"time"
+)
+
+// A request to access an account of a dual control safe.
+type AccessRequest struct {
+	// The start of the requested access period.
+	AccessFrom *time.Time
+
+	// The end of the requested access period.
+	AccessTo *time.Time
+
+	// The way an account of an access request is accessed.
+	AccessType AccessType
+
+	// The unique ID of the account access is requested to.
+	AccountId *string
+
+	// The number of confirmations still required to confirm the request.
+	ConfirmationsLeft *int32
+
+	// The time the request was created.
+	CreationDate *time.Time
+
+	// The time the request expires if not confirmed.
+	ExpirationDate *time.Time
+
+	// Whether the account can be accessed multiple times during the requested
+	// period.
+	MultipleAccessRequired *bool
+
+	// The operation the account is accessed for, e.g. the retrieval of its
+	// password.
+	Operation *string
+
+	// The unique ID of the request.
+	RequestId *string
+
+	// The reason for requesting access.
+	RequestorReason *string
+
+	// The name of the user who created the request.
+	RequestorUserName *string
+
+	// The name of the safe of the account.
+	SafeName *string
+
+	// The numeric status code of the request.
+	Status *int32
+
+	// The status of an access request.
+	StatusTitle AccessRequestStatus
+}
+
+// A privileged account stored in a safe.
+type Account struct {
+	// The address of the machine the account is used on.
+	Address *string
+
+	// The time the properties of the account were last modified.
+	CategoryModificationTime *time.Time
+
+	// The time the account was created.
+	CreatedTime *time.Time
+
+	// The unique ID of the account.
+	Id *string
+
+	// The name of the account.
+	Name *string
+
+	// The platform specific properties of the account.
+	PlatformAccountProperties map[string]string
+
+	// The ID of the platform the account is managed by.
+	PlatformId *string
+
+	// The machines an account can be used to connect to.
+	RemoteMachinesAccess *RemoteMachinesAccess
+
+	// The name of the safe the account is stored in.
+	SafeName *string
+
+	// The way the secret of an account is managed by the CPM.
+	SecretManagement *SecretManagement
+
+	// The type of the secret of an account.
+	SecretType SecretType
+
+	// The user name of the account.
+	UserName *string
+}
+
+// The connection component and the authorization of an ad hoc connection.
+type ConnectPrerequisites struct {
+	// The ID of the PSM connection component the target is connected with, e.g.
+	// PSM-RDP.
+	//
+	// This member is required.
+	ConnectionComponent *string
+
+	// The reason for connecting to the target, if required by the policy of the
+	// platform.
+	Reason *string
+
+	// The ID of the ticket that authorizes the connection.
+	TicketId *string
+
+	// The name of the ticketing system of the ticket that authorizes the
+	// connection.
+	TicketingSystemName *string
+}
+
+// The parameters of a PSM connection, overriding the defaults of the connection
+// component.
+type ConnectionParameters struct {
+	// Whether the session connects to the console of the target, rather than a new
+	// session.
+	AllowConnectToConsole *FlagConnectionParameter
+
+	// Whether the local drives of the user's machine are mapped to the session.
+	AllowMappingLocalDrives *FlagConnectionParameter
+
+	// Whether the session is opened with the PSM HTML5 gateway, if the connection
+	// component allows it.
+	AllowSelectHTML5 *FlagConnectionParameter
+
+	// The domain the account logs on to the target with.
+	LogonDomain *StringConnectionParameter
+
+	// The address of the machine connected to, for accounts that can connect to
+	// several remote machines.
+	PSMRemoteMachine *StringConnectionParameter
+
+	// Whether the smart cards of the user's machine are redirected to the session.
+	RedirectSmartCards *FlagConnectionParameter
+}
+
+// The change policy of the credentials of a target platform.
+type CredentialsChangePolicy struct {
+	// Whether the users can trigger the change manually.
+	AllowManual *bool
+
+	// Whether the credentials are changed when an account is added.
+	AutoOnAdd *bool
+
+	// Whether the credentials are changed automatically.
+	PerformAutomatic *bool
+
+	// The number of days between the automatic changes.
+	RequirePasswordEveryXDays *int32
+}
+
+// The credentials management policy of a target platform.
+type CredentialsManagementPolicy struct {
+	// The change policy of the credentials of a target platform.
+	Change *CredentialsChangePolicy
+
+	// The reconciliation policy of the credentials of a target platform.
+	Reconcile *CredentialsReconcilePolicy
+
+	// The configuration of the updates of the secrets of a target platform.
+	SecretUpdateConfiguration *SecretUpdateConfiguration
+
+	// The verification policy of the credentials of a target platform.
+	Verification *CredentialsVerificationPolicy
+}
+
+// The reconciliation policy of the credentials of a target platform.
+type CredentialsReconcilePolicy struct {
+	// Whether the users can trigger the reconciliation manually.
+	AllowManual *bool
+
+	// Whether the credentials are reconciled automatically when they are found to
+	// be unsynchronized.
+	AutomaticReconcileWhenUnsynced *bool
+}
+
+// The verification policy of the credentials of a target platform.
+type CredentialsVerificationPolicy struct {
+	// Whether the users can trigger the verification manually.
+	AllowManual *bool
+
+	// Whether the credentials are verified when an account is added.
+	AutoOnAdd *bool
+
+	// Whether the credentials are verified automatically.
+	PerformAutomatic *bool
+
+	// The number of days between the automatic verifications.
+	RequirePasswordEveryXDays *int32
+}
+
+// A platform of the dependencies of accounts, e.g. Windows services running
+// with a domain account.
+type DependentPlatform struct {
+	// The unique numeric ID of the dependent platform.
+	Id *int32
+
+	// The name of the platform.
+	Name *string
+
+	// The unique ID of the platform, e.g. WinDomain.
+	PlatformId *string
+}
+
+// A PSM connection parameter enabling or disabling a connection feature.
+type FlagConnectionParameter struct {
+	// The value of a PSM connection parameter that enables or disables a connection
+	// feature.
+	//
+	// This member is required.
+	Value ConnectionFlag
+
+	// Whether the value is saved as the user's default for the connection
+	// component.
+	ShouldSave *bool
+}
+
+// A platform of groups of accounts sharing the same password.
+type GroupPlatform struct {
+	// The unique numeric ID of the group platform.
+	Id *int32
+
+	// The name of the platform.
+	Name *string
+
+	// The unique ID of the platform, e.g. WinDomain.
+	PlatformId *string
+}
+
+// A JSON Patch operation applied to the properties of an account.
+type PatchOperation struct {
+	// The type of a JSON Patch operation.
+	//
+	// This member is required.
+	Op PatchOperationType
+
+	// The path of the property, e.g. /address or /platformAccountProperties/Port.
+	//
+	// This member is required.
+	Path *string
+
+	// The value of the property. Not set for remove operations.
+	Value *string
+}
+
+// The setting of a privileged access workflow of a platform.
+type PlatformWorkflowSetting struct {
+	// Whether the workflow is active.
+	IsActive *bool
+
+	// Whether the workflow setting of the platform overrides the master policy.
+	IsAnException *bool
+}
+
+// A private SSH key in one of the formats it is returned in.
+type PrivateSSHKey struct {
+	// The format of a private SSH key.
+	Format SSHKeyFormat
+
+	// The algorithm of the key, e.g. RSA.
+	KeyAlg *string
+
+	// The private key, encoded in the format.
+	PrivateKey *string
+}
+
+// The privileged access workflows of a target platform.
+type PrivilegedAccessWorkflows struct {
+	// The setting of a privileged access workflow of a platform.
+	EnforceCheckinCheckoutExclusiveAccess *PlatformWorkflowSetting
+
+	// The setting of a privileged access workflow of a platform.
+	EnforceOnetimePasswordAccess *PlatformWorkflowSetting
+
+	// The setting of a privileged access workflow of a platform.
+	RequireDualControlPasswordAccessApproval *PlatformWorkflowSetting
+
+	// The setting of a privileged access workflow of a platform.
+	RequireUsersToSpecifyReasonForAccess *PlatformWorkflowSetting
+}
+
+// The PSM server the sessions of the accounts of a target platform are
+// connected through.
+type PrivilegedSessionManagement struct {
+	// The unique ID of the PSM server.
+	PSMServerId *string
+
+	// The name of the PSM server.
+	PSMServerName *string
+}
+
+// An activity recorded in a PSM session.
+type RecordingActivity struct {
+	// The activity, e.g. a command the user ran, or a window the user opened.
+	Command *string
+
+	// The risk score of the activity.
+	RiskScore *float64
+
+	// The time of the activity.
+	Time *time.Time
+}
+
+// A file of a recording of a PSM session.
+type RecordingFile struct {
+	// The name of the file.
+	FileName *string
+
+	// The size of the file in bytes.
+	FileSize *int64
+
+	// The type of the recording in the file, e.g. Video or Text.
+	RecordingType *string
+}
+
+// The machines an account can be used to connect to.
+type RemoteMachinesAccess struct {
+	// Whether the account can only be used to connect to the remote machines.
+	AccessRestrictedToRemoteMachines *bool
+
+	// The addresses of the machines, separated by a semicolon.
+	RemoteMachines *string
+}
+
+// A platform of groups of accounts whose passwords are changed in rotation.
+type RotationalGroupPlatform struct {
+	// The unique numeric ID of the rotational group platform.
+	Id *int32
+
+	// The name of the platform.
+	Name *string
+
+	// The unique ID of the platform, e.g. WinDomain.
+	PlatformId *string
+}
+
+// A safe accounts are stored in.
+type Safe struct {
+	// Whether expired secret versions are purged automatically.
+	AutoPurgeEnabled *bool
+
+	// The time the safe was created.
+	CreationTime *time.Time
+
+	// The user that created a safe.
+	Creator *SafeCreator
+
+	// The description of the safe.
+	Description *string
+
+	// Whether the membership of the authenticated user has expired.
+	IsExpiredMember *bool
+
+	// The time the safe was last modified, in microseconds since the Unix epoch.
+	LastModificationTime *int64
+
+	// The location of the safe in the vault.
+	Location *string
+
+	// The name of the CPM user that manages the safe.
+	ManagingCPM *string
+
+	// The number of days every secret version is retained in the safe.
+	NumberOfDaysRetention *int32
+
+	// The number of retained versions of every secret in the safe.
+	NumberOfVersionsRetention *int32
+
+	// Whether object level access control is enabled for the safe.
+	OlacEnabled *bool
+
+	// The name of the safe.
+	SafeName *string
+
+	// The unique number of the safe.
+	SafeNumber *int32
+
+	// The unique ID of the safe, used in URLs.
+	SafeUrlId *string
+}
+
+// The user that created a safe.
+type SafeCreator struct {
+	// The unique ID of the user.
+	Id *string
+
+	// The name of the user.
+	Name *string
+}
+
+// The way the secret of an account is managed by the CPM.
+type SecretManagement struct {
+	// Whether the secret is managed automatically by the CPM.
+	AutomaticManagementEnabled *bool
+
+	// The time the secret was last modified.
+	LastModifiedTime *time.Time
+
+	// The time the secret was last reconciled.
+	LastReconciledTime *time.Time
+
+	// The time the secret was last verified.
+	LastVerifiedTime *time.Time
+
+	// The reason the secret is not managed automatically.
+	ManualManagementReason *string
+
+	// The status of the last action the CPM performed on the secret of an account.
+	Status SecretManagementStatus
+}
+
+// The configuration of the updates of the secrets of a target platform.
+type SecretUpdateConfiguration struct {
+	// Whether the passwords are changed with a reset, rather than with the current
+	// password.
+	ChangePasswordInResetMode *bool
+}
+
+// A PSM session, either live or recorded.
+type Session struct {
+	// The address of the account the session connected with.
+	AccountAddress *string
+
+	// The platform of the account the session connected with.
+	AccountPlatformId *string
+
+	// The user name of the account the session connected with.
+	AccountUsername *string
+
+	// Whether the authenticated user can monitor the live session.
+	CanMonitor *bool
+
+	// Whether the authenticated user can suspend and resume the live session.
+	CanSuspend *bool
+
+	// Whether the authenticated user can terminate the live session.
+	CanTerminate *bool
+
+	// The client the user connected with, e.g. RDP.
+	Client *string
+
+	// The ID of the connection component of the session.
+	ConnectionComponentId *string
+
+	// The duration of the session in seconds.
+	Duration *int64
+
+	// The time the session ended. Not set for a live session.
+	End *time.Time
+
+	// The name of the account the session connected with.
+	FileName *string
+
+	// The IP address the user connected from.
+	FromIP *string
+
+	// Whether the session is live.
+	IsLive *bool
+
+	// The protocol of the connection, e.g. SSH.
+	Protocol *string
+
+	// The files of the recording of the session.
+	RecordingFiles []RecordingFile
+
+	// The address of the target the session connected to.
+	RemoteMachine *string
+
+	// The risk score of the session, from its risky activities.
+	RiskScore *float64
+
+	// The name of the safe of the account the session connected with.
+	SafeName *string
+
+	// The GUID of the session.
+	SessionGuid *string
+
+	// The unique ID of the session, and of its recording.
+	SessionId *string
+
+	// The time the session started.
+	Start *time.Time
+
+	// The name of the user that connected.
+	User *string
+}
+
+// A PSM connection parameter with a string value.
+type StringConnectionParameter struct {
+	// The value of the parameter.
+	//
+	// This member is required.
+	Value *string
+
+	// Whether the value is saved as the user's default for the connection
+	// component.
+	ShouldSave *bool
+}
+
+// A platform of accounts of a target system, e.g. Windows domain accounts.
+type TargetPlatform struct {
+	// Whether the platform is active, and accounts can be added with it.
+	Active *bool
+
+	// A regular expression of the names of the safes the accounts of the platform
+	// can be stored in.
+	AllowedSafes *string
+
+	// The credentials management policy of a target platform.
+	CredentialsManagementPolicy *CredentialsManagementPolicy
+
+	// The unique numeric ID of the target platform.
+	Id *int32
+
+	// The name of the platform.
+	Name *string
+
+	// The unique ID of the platform, e.g. WinDomain.
+	PlatformId *string
+
+	// The privileged access workflows of a target platform.
+	PrivilegedAccessWorkflows *PrivilegedAccessWorkflows
+
+	// The PSM server the sessions of the accounts of a target platform are
+	// connected through.
+	PrivilegedSessionManagement *PrivilegedSessionManagement
+
+	// The system type of the platform, e.g. Windows.
+	SystemType *string
+}
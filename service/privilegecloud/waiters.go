@@ -0,0 +1,352 @@
+package privilegecloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/strick-j/cybr-sdk-alpha/cybr/waiter"
+	"github.com/strick-j/cybr-sdk-alpha/internal/sdk"
+	"github.com/strick-j/cybr-sdk-alpha/service/privilegecloud/types"
+	"github.com/strick-j/smithy-go/middleware"
+	smithywaiter "github.com/strick-j/smithy-go/waiter"
+)
+
+// GetAccountAPIClient is a client that implements the GetAccount operation.
+type GetAccountAPIClient interface {
+	GetAccount(context.Context, *GetAccountInput, ...func(*Options)) (*GetAccountOutput, error)
+}
+
+var _ GetAccountAPIClient = (*Client)(nil)
+
+// AccountCPMActionCompletedWaiterOptions are waiter options for
+// AccountCPMActionCompletedWaiter
+type AccountCPMActionCompletedWaiterOptions struct {
+
+	// Set of options to modify how an operation is invoked. These apply to all
+	// operations invoked for this client. Use functional options on operation call to
+	// modify this list for per operation behavior.
+	APIOptions []func(*middleware.Stack) error
+
+	// Functional options to be passed to all operations invoked by this client.
+	// Function values that modify the inner APIOptions are applied after the waiter
+	// config's own APIOptions modifiers.
+	ClientOptions []func(*Options)
+
+	// MinDelay is the minimum amount of time to delay between retries. If unset,
+	// AccountCPMActionCompletedWaiter will use default minimum delay of 15 seconds.
+	// Note that MinDelay must resolve to a value lesser than or equal to the
+	// MaxDelay.
+	MinDelay time.Duration
+
+	// MaxDelay is the maximum amount of time to delay between retries. If unset or
+	// set to zero, AccountCPMActionCompletedWaiter will use default max delay of 120
+	// seconds. Note that MaxDelay must resolve to value greater than or equal to the
+	// MinDelay.
+	MaxDelay time.Duration
+
+	// LogWaitAttempts is used to enable logging for waiter retry attempts
+	LogWaitAttempts bool
+
+	// The time the CPM action was requested. A success status of the
+	// account's secret management is only accepted if the secret was
+	// modified, verified or reconciled at or after RequestedAt, so the status
+	// of a previous action is not mistaken for the status of the action waited
+	// on. As the CPM does not record the time of a failed action, a failure
+	// status is accepted unless all the times recorded are before
+	// RequestedAt. Defaults to the time Wait is called.
+	RequestedAt time.Time
+
+	// Retryable is function that can be used to override the service defined
+	// waiter-behavior based on operation output, or returned error. This function is
+	// used by the waiter to decide if a state is retryable or a terminal state. By
+	// default the AccountCPMActionCompleted state of the account's secret
+	// management is checked, see RequestedAt.
+	Retryable func(context.Context, *GetAccountInput, *GetAccountOutput, error) (bool, error)
+}
+
+// AccountCPMActionCompletedWaiter defines the waiters for
+// AccountCPMActionCompleted, waiting until the CPM action requested on an
+// account, e.g. with ChangeCredentials, VerifyCredentials or
+// ReconcileCredentials, succeeds or fails.
+type AccountCPMActionCompletedWaiter struct {
+	client GetAccountAPIClient
+
+	options AccountCPMActionCompletedWaiterOptions
+}
+
+// NewAccountCPMActionCompletedWaiter constructs a AccountCPMActionCompletedWaiter.
+func NewAccountCPMActionCompletedWaiter(client GetAccountAPIClient, optFns ...func(*AccountCPMActionCompletedWaiterOptions)) *AccountCPMActionCompletedWaiter {
+	options := AccountCPMActionCompletedWaiterOptions{}
+	options.MinDelay = 15 * time.Second
+	options.MaxDelay = 120 * time.Second
+
+	for _, fn := range optFns {
+		fn(&options)
+	}
+	return &AccountCPMActionCompletedWaiter{
+		client:  client,
+		options: options,
+	}
+}
+
+// Wait calls the waiter function for AccountCPMActionCompleted waiter. The
+// maxWaitDur is the maximum wait duration the waiter will wait. The maxWaitDur is
+// required and must be greater than zero.
+func (w *AccountCPMActionCompletedWaiter) Wait(ctx context.Context, params *GetAccountInput, maxWaitDur time.Duration, optFns ...func(*AccountCPMActionCompletedWaiterOptions)) error {
+	_, err := w.WaitForOutput(ctx, params, maxWaitDur, optFns...)
+	return err
+}
+
+// WaitForOutput calls the waiter function for AccountCPMActionCompleted waiter
+// and returns the output of the successful operation. The maxWaitDur is the
+// maximum wait duration the waiter will wait. The maxWaitDur is required and must
+// be greater than zero.
+func (w *AccountCPMActionCompletedWaiter) WaitForOutput(ctx context.Context, params *GetAccountInput, maxWaitDur time.Duration, optFns ...func(*AccountCPMActionCompletedWaiterOptions)) (*GetAccountOutput, error) {
+	options := w.options
+	for _, fn := range optFns {
+		fn(&options)
+	}
+
+	if options.MaxDelay <= 0 {
+		options.MaxDelay = 120 * time.Second
+	}
+
+	if options.RequestedAt.IsZero() {
+		options.RequestedAt = sdk.NowTime()
+	}
+	retryable := options.Retryable
+	if retryable == nil {
+		stateRetryable := waiter.Retryable("AccountCPMActionCompleted",
+			accountCPMActionCompletedAcceptors(options.RequestedAt)...)
+		retryable = func(ctx context.Context, input *GetAccountInput, output *GetAccountOutput, err error) (bool, error) {
+			return stateRetryable(output, err)
+		}
+	}
+
+	logger := smithywaiter.Logger{}
+
+	return waiter.Wait(ctx, "AccountCPMActionCompleted", maxWaitDur,
+		waiter.Options{MinDelay: options.MinDelay, MaxDelay: options.MaxDelay},
+		func(ctx context.Context, attempt int64) (*GetAccountOutput, error) {
+			apiOptions := options.APIOptions
+			if options.LogWaitAttempts {
+				logger.Attempt = attempt
+				apiOptions = append([]func(*middleware.Stack) error{}, options.APIOptions...)
+				apiOptions = append(apiOptions, logger.AddLogger)
+			}
+
+			return w.client.GetAccount(ctx, params, func(o *Options) {
+				o.APIOptions = append(o.APIOptions, apiOptions...)
+				for _, opt := range options.ClientOptions {
+					opt(o)
+				}
+			})
+		},
+		func(ctx context.Context, output *GetAccountOutput, err error) (bool, error) {
+			return retryable(ctx, params, output, err)
+		},
+	)
+}
+
+// accountCPMActionCompletedAcceptors returns the acceptors of the
+// AccountCPMActionCompleted waiter for a CPM action requested at requestedAt.
+func accountCPMActionCompletedAcceptors(requestedAt time.Time) []waiter.Acceptor[*GetAccountOutput] {
+	// The times are in whole seconds.
+	since := requestedAt.Truncate(time.Second)
+
+	failure := waiter.OutputAcceptor(waiter.StateFailure, func(output *GetAccountOutput) bool {
+		sm := output.SecretManagement
+		return sm != nil && sm.Status == types.SecretManagementStatusFailure && !updatedBefore(sm, since)
+	})
+	failure.Reason = func(output *GetAccountOutput, err error) string {
+		reason := "unknown reason"
+		if sm := output.SecretManagement; sm.ManualManagementReason != nil && len(*sm.ManualManagementReason) != 0 {
+			reason = *sm.ManualManagementReason
+		}
+		return "CPM action failed, " + reason
+	}
+
+	return []waiter.Acceptor[*GetAccountOutput]{
+		failure,
+		waiter.OutputAcceptor(waiter.StateSuccess, func(output *GetAccountOutput) bool {
+			sm := output.SecretManagement
+			return sm != nil && sm.Status == types.SecretManagementStatusSuccess && updatedSince(sm, since)
+		}),
+	}
+}
+
+// updatedSince returns if the secret was modified, verified or reconciled at
+// or after since.
+func updatedSince(sm *types.SecretManagement, since time.Time) bool {
+	for _, t := range []*time.Time{sm.LastModifiedTime, sm.LastVerifiedTime, sm.LastReconciledTime} {
+		if t != nil && !t.Before(since) {
+			return true
+		}
+	}
+	return false
+}
+
+// updatedBefore returns if the secret was last modified, verified or
+// reconciled before since. Returns false if none of the times are recorded.
+func updatedBefore(sm *types.SecretManagement, since time.Time) bool {
+	var recorded bool
+	for _, t := range []*time.Time{sm.LastModifiedTime, sm.LastVerifiedTime, sm.LastReconciledTime} {
+		if t == nil {
+			continue
+		}
+		if !t.Before(since) {
+			return false
+		}
+		recorded = true
+	}
+	return recorded
+}
+
+// GetRequestAPIClient is a client that implements the GetRequest
+// operation.
+type GetRequestAPIClient interface {
+	GetRequest(context.Context, *GetRequestInput, ...func(*Options)) (*GetRequestOutput, error)
+}
+
+var _ GetRequestAPIClient = (*Client)(nil)
+
+// AccessRequestConfirmedWaiterOptions are waiter options for
+// AccessRequestConfirmedWaiter
+type AccessRequestConfirmedWaiterOptions struct {
+
+	// Set of options to modify how an operation is invoked. These apply to all
+	// operations invoked for this client. Use functional options on operation call to
+	// modify this list for per operation behavior.
+	APIOptions []func(*middleware.Stack) error
+
+	// Functional options to be passed to all operations invoked by this client.
+	// Function values that modify the inner APIOptions are applied after the waiter
+	// config's own APIOptions modifiers.
+	ClientOptions []func(*Options)
+
+	// MinDelay is the minimum amount of time to delay between retries. If unset,
+	// AccessRequestConfirmedWaiter will use default minimum delay of 30 seconds.
+	// Note that MinDelay must resolve to a value lesser than or equal to the
+	// MaxDelay.
+	MinDelay time.Duration
+
+	// MaxDelay is the maximum amount of time to delay between retries. If unset or
+	// set to zero, AccessRequestConfirmedWaiter will use default max delay of 300
+	// seconds. Note that MaxDelay must resolve to value greater than or equal to the
+	// MinDelay.
+	MaxDelay time.Duration
+
+	// LogWaitAttempts is used to enable logging for waiter retry attempts
+	LogWaitAttempts bool
+
+	// Retryable is function that can be used to override the service defined
+	// waiter-behavior based on operation output, or returned error. This function is
+	// used by the waiter to decide if a state is retryable or a terminal state. By
+	// default the request is waited on until it is confirmed, and the waiter
+	// fails if the request is rejected, expires or is deleted.
+	Retryable func(context.Context, *GetRequestInput, *GetRequestOutput, error) (bool, error)
+}
+
+// AccessRequestConfirmedWaiter defines the waiters for AccessRequestConfirmed,
+// waiting until an access request created with CreateRequest is
+// confirmed, so the account of the request can be accessed, e.g. with
+// RetrievePassword.
+type AccessRequestConfirmedWaiter struct {
+	client GetRequestAPIClient
+
+	options AccessRequestConfirmedWaiterOptions
+}
+
+// NewAccessRequestConfirmedWaiter constructs a AccessRequestConfirmedWaiter.
+func NewAccessRequestConfirmedWaiter(client GetRequestAPIClient, optFns ...func(*AccessRequestConfirmedWaiterOptions)) *AccessRequestConfirmedWaiter {
+	options := AccessRequestConfirmedWaiterOptions{}
+	options.MinDelay = 30 * time.Second
+	options.MaxDelay = 300 * time.Second
+
+	for _, fn := range optFns {
+		fn(&options)
+	}
+	return &AccessRequestConfirmedWaiter{
+		client:  client,
+		options: options,
+	}
+}
+
+// Wait calls the waiter function for AccessRequestConfirmed waiter. The
+// maxWaitDur is the maximum wait duration the waiter will wait. The maxWaitDur is
+// required and must be greater than zero.
+func (w *AccessRequestConfirmedWaiter) Wait(ctx context.Context, params *GetRequestInput, maxWaitDur time.Duration, optFns ...func(*AccessRequestConfirmedWaiterOptions)) error {
+	_, err := w.WaitForOutput(ctx, params, maxWaitDur, optFns...)
+	return err
+}
+
+// WaitForOutput calls the waiter function for AccessRequestConfirmed waiter
+// and returns the output of the successful operation. The maxWaitDur is the
+// maximum wait duration the waiter will wait. The maxWaitDur is required and must
+// be greater than zero.
+func (w *AccessRequestConfirmedWaiter) WaitForOutput(ctx context.Context, params *GetRequestInput, maxWaitDur time.Duration, optFns ...func(*AccessRequestConfirmedWaiterOptions)) (*GetRequestOutput, error) {
+	options := w.options
+	for _, fn := range optFns {
+		fn(&options)
+	}
+
+	if options.MaxDelay <= 0 {
+		options.MaxDelay = 300 * time.Second
+	}
+
+	retryable := options.Retryable
+	if retryable == nil {
+		stateRetryable := waiter.Retryable("AccessRequestConfirmed", accessRequestConfirmedAcceptors()...)
+		retryable = func(ctx context.Context, input *GetRequestInput, output *GetRequestOutput, err error) (bool, error) {
+			return stateRetryable(output, err)
+		}
+	}
+
+	logger := smithywaiter.Logger{}
+
+	return waiter.Wait(ctx, "AccessRequestConfirmed", maxWaitDur,
+		waiter.Options{MinDelay: options.MinDelay, MaxDelay: options.MaxDelay},
+		func(ctx context.Context, attempt int64) (*GetRequestOutput, error) {
+			apiOptions := options.APIOptions
+			if options.LogWaitAttempts {
+				logger.Attempt = attempt
+				apiOptions = append([]func(*middleware.Stack) error{}, options.APIOptions...)
+				apiOptions = append(apiOptions, logger.AddLogger)
+			}
+
+			return w.client.GetRequest(ctx, params, func(o *Options) {
+				o.APIOptions = append(o.APIOptions, apiOptions...)
+				for _, opt := range options.ClientOptions {
+					opt(o)
+				}
+			})
+		},
+		func(ctx context.Context, output *GetRequestOutput, err error) (bool, error) {
+			return retryable(ctx, params, output, err)
+		},
+	)
+}
+
+// accessRequestConfirmedAcceptors returns the acceptors of the
+// AccessRequestConfirmed waiter.
+func accessRequestConfirmedAcceptors() []waiter.Acceptor[*GetRequestOutput] {
+	failure := waiter.OutputAcceptor(waiter.StateFailure, func(output *GetRequestOutput) bool {
+		switch output.StatusTitle {
+		case types.AccessRequestStatusRejected, types.AccessRequestStatusExpired, types.AccessRequestStatusDeleted:
+			return true
+		}
+		return false
+	})
+	failure.Reason = func(output *GetRequestOutput, err error) string {
+		return fmt.Sprintf("access request %s", strings.ToLower(string(output.StatusTitle)))
+	}
+
+	return []waiter.Acceptor[*GetRequestOutput]{
+		waiter.OutputAcceptor(waiter.StateSuccess, func(output *GetRequestOutput) bool {
+			return output.StatusTitle == types.AccessRequestStatusConfirmed
+		}),
+		failure,
+	}
+}
@@ -0,0 +1,175 @@
+package privilegecloud
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/strick-j/cybr-sdk-alpha/cybr"
+	"github.com/strick-j/cybr-sdk-alpha/internal/sdk"
+)
+
+// sequenceHTTPClient responds to each request with the next of the response
+// bodies, repeating the last.
+type sequenceHTTPClient struct {
+	bodies   []string
+	requests int
+}
+
+func (c *sequenceHTTPClient) Do(*http.Request) (*http.Response, error) {
+	body := c.bodies[len(c.bodies)-1]
+	if c.requests < len(c.bodies) {
+		body = c.bodies[c.requests]
+	}
+	c.requests++
+
+	return &http.Response{
+		StatusCode: 200,
+		Header:     http.Header{},
+		Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+	}, nil
+}
+
+func accountBody(status string, lastModified time.Time) string {
+	return fmt.Sprintf(`{"id":"12_3","secretManagement":{"status":%q,"lastModifiedTime":%d}}`,
+		status, lastModified.Unix())
+}
+
+func TestAccountCPMActionCompletedWaiter(t *testing.T) {
+	requestedAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	defer sdk.TestingUseNopSleep()()
+	defer sdk.TestingUseReferenceTime(requestedAt)()
+
+	cases := map[string]struct {
+		Bodies      []string
+		MaxWait     time.Duration
+		ExpectErr   string
+		ExpectCalls int
+	}{
+		"success after previous action": {
+			Bodies: []string{
+				accountBody("success", requestedAt.Add(-time.Hour)),
+				`{"id":"12_3","secretManagement":{}}`,
+				accountBody("success", requestedAt.Add(time.Minute)),
+			},
+			MaxWait:     time.Hour,
+			ExpectCalls: 3,
+		},
+		"failure": {
+			Bodies: []string{
+				accountBody("success", requestedAt.Add(-time.Hour)),
+				fmt.Sprintf(`{"id":"12_3","secretManagement":{"status":"failure","manualManagementReason":"bad logon","lastModifiedTime":%d}}`,
+					requestedAt.Add(time.Minute).Unix()),
+			},
+			MaxWait:     time.Hour,
+			ExpectErr:   "CPM action failed, bad logon",
+			ExpectCalls: 2,
+		},
+		"success after previous failure": {
+			Bodies: []string{
+				fmt.Sprintf(`{"id":"12_3","secretManagement":{"status":"failure","manualManagementReason":"bad logon","lastModifiedTime":%d}}`,
+					requestedAt.Add(-time.Hour).Unix()),
+				fmt.Sprintf(`{"id":"12_3","secretManagement":{"status":"failure","manualManagementReason":"bad logon","lastModifiedTime":%d,"lastVerifiedTime":%d}}`,
+					requestedAt.Add(-time.Hour).Unix(), requestedAt.Add(-time.Minute).Unix()),
+				accountBody("success", requestedAt.Add(time.Minute)),
+			},
+			MaxWait:     time.Hour,
+			ExpectCalls: 3,
+		},
+		"failure without times": {
+			Bodies: []string{
+				`{"id":"12_3","secretManagement":{"status":"failure","manualManagementReason":"bad logon"}}`,
+			},
+			MaxWait:     time.Hour,
+			ExpectErr:   "CPM action failed, bad logon",
+			ExpectCalls: 1,
+		},
+		"exceeded max wait": {
+			Bodies: []string{
+				accountBody("success", requestedAt.Add(-time.Hour)),
+			},
+			MaxWait:   time.Minute,
+			ExpectErr: "exceeded max wait time",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			httpClient := &sequenceHTTPClient{bodies: c.Bodies}
+			client := newTestClient(httpClient)
+
+			waiter := NewAccountCPMActionCompletedWaiter(client, func(o *AccountCPMActionCompletedWaiterOptions) {
+				o.MinDelay = 15 * time.Second
+				o.MaxDelay = 30 * time.Second
+			})
+			err := waiter.Wait(context.Background(), &GetAccountInput{AccountId: cybr.String("12_3")}, c.MaxWait)
+			if len(c.ExpectErr) != 0 {
+				if err == nil || !strings.Contains(err.Error(), c.ExpectErr) {
+					t.Fatalf("expect error containing %q, got %v", c.ExpectErr, err)
+				}
+			} else if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.ExpectCalls, httpClient.requests; e != 0 && e != a {
+				t.Errorf("expect %v requests, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestAccessRequestConfirmedWaiter(t *testing.T) {
+	defer sdk.TestingUseNopSleep()()
+
+	cases := map[string]struct {
+		Bodies      []string
+		ExpectErr   string
+		ExpectCalls int
+	}{
+		"confirmed": {
+			Bodies: []string{
+				`{"RequestID":"r1","StatusTitle":"Waiting","ConfirmationsLeft":2}`,
+				`{"RequestID":"r1","StatusTitle":"Waiting","ConfirmationsLeft":1}`,
+				`{"RequestID":"r1","StatusTitle":"Confirmed","ConfirmationsLeft":0}`,
+			},
+			ExpectCalls: 3,
+		},
+		"rejected": {
+			Bodies: []string{
+				`{"RequestID":"r1","StatusTitle":"Waiting"}`,
+				`{"RequestID":"r1","StatusTitle":"Rejected"}`,
+			},
+			ExpectErr:   "AccessRequestConfirmed waiter state transitioned to Failure, access request rejected",
+			ExpectCalls: 2,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			httpClient := &sequenceHTTPClient{bodies: c.Bodies}
+			client := newTestClient(httpClient)
+
+			waiter := NewAccessRequestConfirmedWaiter(client)
+			out, err := waiter.WaitForOutput(context.Background(), &GetRequestInput{RequestId: cybr.String("r1")}, time.Hour)
+			if len(c.ExpectErr) != 0 {
+				if err == nil || err.Error() != c.ExpectErr {
+					t.Fatalf("expect error %q, got %v", c.ExpectErr, err)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("expect no error, got %v", err)
+				}
+				if e, a := "r1", cybr.ToString(out.RequestId); e != a {
+					t.Errorf("expect request %v, got %v", e, a)
+				}
+			}
+			if e, a := c.ExpectCalls, httpClient.requests; e != a {
+				t.Errorf("expect %v requests, got %v", e, a)
+			}
+		})
+	}
+}